@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"io"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
@@ -15,6 +16,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -40,13 +42,17 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
+	"github.com/spf13/cast"
 
 	"speculod/docs"
+	"speculod/indexer"
 	predictionmodulekeeper "speculod/x/prediction/keeper"
+	predictionmoduletypes "speculod/x/prediction/types"
 	reputationmodulekeeper "speculod/x/reputation/keeper"
 	settlementmodulekeeper "speculod/x/settlement/keeper"
 	speculodmodulekeeper "speculod/x/speculod/keeper"
@@ -109,6 +115,11 @@ type App struct {
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// predictionStream streams order book updates to gRPC subscribers
+	predictionStream *predictionmodulekeeper.StreamServer
+	// indexer writes the committed blocks into SQL, nil unless indexer.dsn is set
+	indexer *indexer.Listener
 }
 
 func init() {
@@ -225,6 +236,12 @@ func New(
 		return app.App.InitChainer(ctx, req)
 	})
 
+	app.registerPredictionStream()
+	if err := app.registerIndexer(appOpts); err != nil {
+		panic(err)
+	}
+	app.setUpgradeHandlers()
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
@@ -285,6 +302,64 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 	docs.RegisterOpenAPIService(Name, apiSvr.Router)
 }
 
+// RegisterGRPCServer registers the app's gRPC query services and the
+// prediction order book stream, which cannot be routed through the query router.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.App.RegisterGRPCServer(server)
+	predictionmoduletypes.RegisterStreamServer(server, app.predictionStream)
+}
+
+// registerPredictionStream feeds the prediction order book stream from the
+// changes committed to the prediction store.
+func (app *App) registerPredictionStream() {
+	app.predictionStream = predictionmodulekeeper.NewStreamServer(app.PredictionKeeper, func() (sdk.Context, error) {
+		return app.CreateQueryContext(0, false)
+	})
+	app.CommitMultiStore().AddListeners([]storetypes.StoreKey{app.GetKey(predictionmoduletypes.StoreKey)})
+
+	streamingManager := app.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, app.predictionStream)
+	app.SetStreamingManager(streamingManager)
+}
+
+// registerIndexer writes the committed blocks into the SQL indexer when
+// indexer.dsn is set in the app options. The blocks the node committed while
+// the indexer was not running are first indexed from the data directory,
+// before the node opens it.
+func (app *App) registerIndexer(appOpts servertypes.AppOptions) error {
+	dsn := cast.ToString(appOpts.Get(indexer.FlagDSN))
+	if dsn == "" {
+		return nil
+	}
+	idx, err := indexer.Open(dsn)
+	if err != nil {
+		return err
+	}
+	logger := app.Logger().With("module", "indexer")
+	if home := cast.ToString(appOpts.Get(flags.FlagHome)); home != "" {
+		height, err := idx.SyncDataDir(context.Background(), home, cast.ToString(appOpts.Get("db_backend")))
+		if err != nil {
+			logger.Error("failed to index the blocks of the data directory", "height", height, "err", err)
+		}
+	}
+	app.indexer = indexer.NewListener(idx, logger)
+
+	streamingManager := app.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, app.indexer)
+	app.SetStreamingManager(streamingManager)
+	return nil
+}
+
+// Close writes the blocks queued for the indexer and closes the app
+func (app *App) Close() error {
+	if app.indexer != nil {
+		if err := app.indexer.Close(); err != nil {
+			return err
+		}
+	}
+	return app.App.Close()
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 //
 // NOTE: This is solely to be used for testing purposes.
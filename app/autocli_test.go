@@ -0,0 +1,173 @@
+package app
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/client/v2/autocli/flag"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	predictionmodule "speculod/x/prediction/module"
+	reputationmodule "speculod/x/reputation/module"
+	settlementmodule "speculod/x/settlement/module"
+)
+
+// captureConn records the last query sent through it and answers with an
+// empty response
+type captureConn struct {
+	method  string
+	request proto.Message
+}
+
+func (c *captureConn) Invoke(_ context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	c.method = method
+	c.request = args.(proto.Message)
+	populate(reply.(proto.Message).ProtoReflect(), 3)
+	return nil
+}
+
+func (c *captureConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+// populate sets the singular message fields of m to empty messages, as the
+// CLI refuses to print responses missing non-nullable fields
+func populate(m protoreflect.Message, depth int) {
+	if depth == 0 {
+		return
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.MessageKind && fd.Cardinality() != protoreflect.Repeated {
+			populate(m.Mutable(fd).Message(), depth-1)
+		}
+	}
+}
+
+func autoCLIBuilder(t *testing.T, conn grpc.ClientConnInterface) *autocli.Builder {
+	t.Helper()
+	b := &autocli.Builder{
+		Builder: flag.Builder{
+			TypeResolver:          protoregistry.GlobalTypes,
+			FileResolver:          gogoproto.HybridResolver,
+			AddressCodec:          addresscodec.NewBech32Codec(AccountAddressPrefix),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(AccountAddressPrefix + "valoper"),
+			ConsensusAddressCodec: addresscodec.NewBech32Codec(AccountAddressPrefix + "valcons"),
+		},
+		GetClientConn:     func(*cobra.Command) (grpc.ClientConnInterface, error) { return conn, nil },
+		AddQueryConnFlags: flags.AddQueryFlagsToCmd,
+		AddTxConnFlags:    flags.AddTxFlagsToCmd,
+	}
+	require.NoError(t, b.ValidateAndComplete())
+	return b
+}
+
+func customModuleAutoCLIOptions() map[string]*autocliv1.ModuleOptions {
+	return map[string]*autocliv1.ModuleOptions{
+		"prediction": predictionmodule.AppModule{}.AutoCLIOptions(),
+		"settlement": settlementmodule.AppModule{}.AutoCLIOptions(),
+		"reputation": reputationmodule.AppModule{}.AutoCLIOptions(),
+	}
+}
+
+func TestAutoCLICoverage(t *testing.T) {
+	b := autoCLIBuilder(t, &captureConn{})
+	for name, opts := range customModuleAutoCLIOptions() {
+		for _, svc := range []*autocliv1.ServiceCommandDescriptor{opts.Query, opts.Tx} {
+			desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(svc.Service))
+			require.NoError(t, err)
+			methods := desc.(protoreflect.ServiceDescriptor).Methods()
+
+			// Every RPC is described, not left to the generated defaults
+			described := make(map[string]bool)
+			for _, opt := range svc.RpcCommandOptions {
+				described[opt.RpcMethod] = true
+			}
+			for i := 0; i < methods.Len(); i++ {
+				require.True(t, described[string(methods.Get(i).Name())], "%s: %s has no autocli descriptor", name, methods.Get(i).FullName())
+			}
+		}
+
+		// The descriptors must build, which validates their positional args
+		queryCmd := &cobra.Command{Use: name}
+		require.NoError(t, b.AddQueryServiceCommands(queryCmd, opts.Query), name)
+		txCmd := &cobra.Command{Use: name}
+		require.NoError(t, b.AddMsgServiceCommands(txCmd, opts.Tx), name)
+	}
+}
+
+func TestAutoCLIQueryArgs(t *testing.T) {
+	testCases := []struct {
+		module  string
+		args    []string
+		method  string
+		request string
+	}{
+		{
+			module:  "prediction",
+			args:    []string{"orders", "4", "1"},
+			method:  "/speculod.prediction.v1.Query/Orders",
+			request: `{"marketId":"4","outcomeIndex":1,"pagination":{}}`,
+		},
+		{
+			module:  "prediction",
+			args:    []string{"depth", "4", "0", "--levels", "5"},
+			method:  "/speculod.prediction.v1.Query/Depth",
+			request: `{"marketId":"4","levels":5}`,
+		},
+		{
+			module:  "prediction",
+			args:    []string{"simulate-order", "alice", "4", "1", "BUY", "0.55", "100stake"},
+			method:  "/speculod.prediction.v1.Query/SimulateOrder",
+			request: `{"creator":"alice","marketId":"4","outcomeIndex":1,"side":"BUY","price":"0.55","amount":{"denom":"stake","amount":"100"}}`,
+		},
+		{
+			module:  "settlement",
+			args:    []string{"reveals", "7"},
+			method:  "/speculod.settlement.v1.Query/Reveals",
+			request: `{"marketId":"7"}`,
+		},
+		{
+			module:  "reputation",
+			args:    []string{"score", "alice", "--group-id", "weather"},
+			method:  "/speculod.reputation.v1.Query/Score",
+			request: `{"address":"alice","groupId":"weather"}`,
+		},
+		{
+			module:  "reputation",
+			args:    []string{"scores"},
+			method:  "/speculod.reputation.v1.Query/Scores",
+			request: `{"pagination":{}}`,
+		},
+	}
+
+	opts := customModuleAutoCLIOptions()
+	for _, tc := range testCases {
+		t.Run(tc.module+" "+tc.args[0], func(t *testing.T) {
+			conn := &captureConn{}
+			cmd := &cobra.Command{Use: tc.module}
+			require.NoError(t, autoCLIBuilder(t, conn).AddQueryServiceCommands(cmd, opts[tc.module].Query))
+			cmd.SetArgs(tc.args)
+			cmd.SetOut(io.Discard)
+			require.NoError(t, cmd.ExecuteContext(context.Background()))
+
+			require.Equal(t, tc.method, conn.method)
+			got, err := protojson.Marshal(conn.request)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.request, string(got))
+		})
+	}
+}
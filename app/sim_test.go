@@ -22,6 +22,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -44,6 +46,46 @@ func init() {
 	flag.BoolVar(&FlagEnableStreamingValue, "EnableStreaming", false, "Enable streaming service")
 }
 
+// invariantRegistry collects the invariants registered by the app modules
+type invariantRegistry []sdk.Invariant
+
+func (r *invariantRegistry) RegisterRoute(_, _ string, invar sdk.Invariant) {
+	*r = append(*r, invar)
+}
+
+// simulationOperations returns the simulation operations of the app, each
+// followed by an assertion of the module invariants. The chain never asserts
+// them itself, as a broken invariant would halt it.
+func simulationOperations(app *App, config simulationtypes.Config) []simulationtypes.WeightedOperation {
+	var invariants invariantRegistry
+	for _, m := range app.ModuleManager.Modules {
+		if m, ok := m.(module.HasInvariants); ok {
+			m.RegisterInvariants(&invariants)
+		}
+	}
+	ops := simtestutil.SimulationOperations(app, app.AppCodec(), config)
+	for i, op := range ops {
+		ops[i] = simulation.NewWeightedOperation(op.Weight(), assertInvariants(op.Op(), invariants))
+	}
+	return ops
+}
+
+// assertInvariants fails an operation that breaks one of the invariants
+func assertInvariants(op simulationtypes.Operation, invariants []sdk.Invariant) simulationtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulationtypes.Account, chainID string) (simulationtypes.OperationMsg, []simulationtypes.FutureOperation, error) {
+		opMsg, futureOps, err := op(r, app, ctx, accs, chainID)
+		if err != nil {
+			return opMsg, futureOps, err
+		}
+		for _, invariant := range invariants {
+			if res, broken := invariant(ctx); broken {
+				return opMsg, futureOps, fmt.Errorf("invariant broken after %s %s: %s", opMsg.Route, opMsg.Name, res)
+			}
+		}
+		return opMsg, futureOps, nil
+	}
+}
+
 // fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
 // an IAVLStore for faster simulation speed.
 func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
@@ -94,7 +136,7 @@ func BenchmarkSimulation(b *testing.B) {
 		bApp.BaseApp,
 		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -141,7 +183,7 @@ func TestFullAppSimulation(t *testing.T) {
 		app.BaseApp,
 		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		simulationOperations(app, config),
 		BlockedAddresses(),
 		config,
 		app.AppCodec(),
@@ -185,7 +227,7 @@ func TestAppImportExport(t *testing.T) {
 		bApp.BaseApp,
 		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -236,6 +278,15 @@ func TestAppImportExport(t *testing.T) {
 	require.NoError(t, err)
 	err = newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 	require.NoError(t, err)
+
+	fmt.Printf("comparing reputation state...\n")
+
+	reputationA, err := bApp.ReputationKeeper.ExportGenesis(ctxA)
+	require.NoError(t, err)
+	reputationB, err := newApp.ReputationKeeper.ExportGenesis(ctxB)
+	require.NoError(t, err)
+	require.Equal(t, reputationA, reputationB)
+
 	fmt.Printf("comparing stores...\n")
 
 	// skip certain prefixes
@@ -305,7 +356,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.BaseApp,
 		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -355,7 +406,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		newApp.BaseApp,
 		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(newApp, newApp.AppCodec(), config),
+		simulationOperations(newApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -439,7 +490,7 @@ func TestAppStateDeterminism(t *testing.T) {
 					bApp.DefaultGenesis(),
 				),
 				simulationtypes.RandomAccounts,
-				simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+				simulationOperations(bApp, config),
 				BlockedAddresses(),
 				config,
 				bApp.AppCodec(),
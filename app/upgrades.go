@@ -0,0 +1,24 @@
+package app
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// UpgradeName is the name of the upgrade that moves the custom modules to
+// consensus version 2.
+const UpgradeName = "v2"
+
+// setUpgradeHandlers registers the handlers of the named upgrades. Each one
+// runs the store migrations registered by the modules whose consensus
+// version changed since fromVM.
+func (app *App) setUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	predictionmoduletypes "speculod/x/prediction/types"
+	reputationmoduletypes "speculod/x/reputation/types"
+	settlementmoduletypes "speculod/x/settlement/types"
+	speculodmoduletypes "speculod/x/speculod/types"
+)
+
+func TestUpgradeV2(t *testing.T) {
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	require.True(t, app.UpgradeKeeper.HasHandler(UpgradeName))
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10})
+	cdc := app.AppCodec()
+
+	// Seed the custom modules with v1 state
+	fromVM := app.ModuleManager.GetVersionMap()
+	customModules := []string{
+		speculodmoduletypes.ModuleName,
+		predictionmoduletypes.ModuleName,
+		settlementmoduletypes.ModuleName,
+		reputationmoduletypes.ModuleName,
+	}
+	for _, name := range customModules {
+		fromVM[name] = 1
+	}
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+
+	amount := sdk.NewInt64Coin("stake", 5)
+	position := predictionmoduletypes.Position{MarketId: 3, Owner: "alice", Probability: "0.5", IsBuy: true, Amount: &amount}
+	ctx.KVStore(app.GetKey(predictionmoduletypes.StoreKey)).
+		Set(append(predictionmoduletypes.PositionsKey.Bytes(), "3/alice/1"...), cdc.MustMarshal(&position))
+	commit := settlementmoduletypes.VoteCommit{MarketId: 3, Voter: "alice", Commitment: "aa"}
+	ctx.KVStore(app.GetKey(settlementmoduletypes.StoreKey)).
+		Set(append(settlementmoduletypes.CommitsKey.Bytes(), "3/alice"...), cdc.MustMarshal(&commit))
+	score := reputationmoduletypes.ReputationScore{Address: "alice", GroupId: "weather", Score: "7"}
+	ctx.KVStore(app.GetKey(reputationmoduletypes.StoreKey)).
+		Set(append(reputationmoduletypes.ReputationScoresKey.Bytes(), "alice:weather"...), cdc.MustMarshal(&score))
+
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: UpgradeName, Height: ctx.BlockHeight()}))
+
+	vm, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	for _, name := range customModules {
+		require.Equal(t, uint64(2), vm[name], name)
+	}
+
+	gotPosition, found := app.PredictionKeeper.GetPosition(ctx, 3, "alice", 1)
+	require.True(t, found)
+	require.Equal(t, position, gotPosition)
+	exposure, err := app.PredictionKeeper.GetExposure(ctx, 3, "alice", 1)
+	require.NoError(t, err)
+	require.Equal(t, amount.Amount, exposure.Position)
+	params, err := app.PredictionKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, predictionmoduletypes.DefaultTemplateLimits(), params.TemplateLimits)
+	gotCommit, found := app.SettlementKeeper.GetCommit(ctx, 3, "alice")
+	require.True(t, found)
+	require.Equal(t, commit, gotCommit)
+	gotScore, found := app.ReputationKeeper.GetReputationScore(ctx, "alice", "weather")
+	require.True(t, found)
+	require.Equal(t, score.Score, gotScore)
+	_, err = app.SpeculodKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,167 @@
+// Package client is a typed Go client for a Speculo chain. It wraps the query
+// services of the prediction, settlement and reputation modules and builds,
+// signs and broadcasts transactions with keys from a keyring.
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"cosmossdk.io/x/tx/signing"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	cmtservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+
+	predictiontypes "speculod/x/prediction/types"
+	reputationtypes "speculod/x/reputation/types"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+const (
+	// DefaultGasAdjustment is the factor applied to simulated gas
+	DefaultGasAdjustment = 1.5
+	// DefaultPollInterval is how often a broadcast tx is looked up until it is included
+	DefaultPollInterval = 500 * time.Millisecond
+)
+
+// Client talks to a Speculo node over gRPC. It is safe for concurrent use.
+type Client struct {
+	Prediction predictiontypes.QueryClient
+	Settlement settlementtypes.QueryClient
+	Reputation reputationtypes.QueryClient
+	Bank       banktypes.QueryClient
+
+	auth     authtypes.QueryClient
+	tx       txtypes.ServiceClient
+	keyring  keyring.Keyring
+	codec    codec.Codec
+	txConfig sdkclient.TxConfig
+
+	chainID       string
+	gasPrices     string
+	gasAdjustment float64
+	pollInterval  time.Duration
+
+	// accounts caches the account number and next sequence of each signer
+	mu       sync.Mutex
+	accounts map[string]*account
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithChainID sets the chain ID used for signing instead of asking the node
+func WithChainID(chainID string) Option {
+	return func(c *Client) { c.chainID = chainID }
+}
+
+// WithGasPrices sets the gas prices the fees are computed from, e.g. "0.025stake"
+func WithGasPrices(gasPrices string) Option {
+	return func(c *Client) { c.gasPrices = gasPrices }
+}
+
+// WithGasAdjustment sets the factor applied to simulated gas
+func WithGasAdjustment(adjustment float64) Option {
+	return func(c *Client) { c.gasAdjustment = adjustment }
+}
+
+// WithPollInterval sets how often a broadcast tx is looked up until it is included
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *Client) { c.pollInterval = interval }
+}
+
+// New returns a client sending queries and transactions through conn and
+// signing with the keys of kr. The chain ID is queried from the node unless
+// set with WithChainID.
+func New(ctx context.Context, conn grpc.ClientConnInterface, kr keyring.Keyring, opts ...Option) (*Client, error) {
+	cdc, err := newCodec()
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		Prediction:    predictiontypes.NewQueryClient(conn),
+		Settlement:    settlementtypes.NewQueryClient(conn),
+		Reputation:    reputationtypes.NewQueryClient(conn),
+		Bank:          banktypes.NewQueryClient(conn),
+		auth:          authtypes.NewQueryClient(conn),
+		tx:            txtypes.NewServiceClient(conn),
+		keyring:       kr,
+		codec:         cdc,
+		txConfig:      authtx.NewTxConfig(cdc, authtx.DefaultSignModes),
+		gasAdjustment: DefaultGasAdjustment,
+		pollInterval:  DefaultPollInterval,
+		accounts:      make(map[string]*account),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.chainID == "" {
+		info, err := cmtservice.NewServiceClient(conn).GetNodeInfo(ctx, &cmtservice.GetNodeInfoRequest{})
+		if err != nil {
+			return nil, err
+		}
+		c.chainID = info.DefaultNodeInfo.Network
+	}
+	return c, nil
+}
+
+// ChainID returns the chain ID transactions are signed for
+func (c *Client) ChainID() string {
+	return c.chainID
+}
+
+// Address returns the address of the key named name
+func (c *Client) Address(name string) (sdk.AccAddress, error) {
+	record, err := c.keyring.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	return record.GetAddress()
+}
+
+// NewKeyring opens the keyring of the given backend ("os", "file", "test",
+// ...) stored in dir. input is read for the passphrases of the file backend.
+func NewKeyring(backend, dir string, input io.Reader) (keyring.Keyring, error) {
+	cdc, err := newCodec()
+	if err != nil {
+		return nil, err
+	}
+	return keyring.New(sdk.KeyringServiceName(), backend, dir, input, cdc)
+}
+
+// newCodec registers the interfaces needed to sign the module messages and
+// to decode accounts and message responses
+func newCodec() (codec.Codec, error) {
+	config := sdk.GetConfig()
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: gogoproto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addresscodec.NewBech32Codec(config.GetBech32AccountAddrPrefix()),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(config.GetBech32ValidatorAddrPrefix()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	predictiontypes.RegisterInterfaces(registry)
+	settlementtypes.RegisterInterfaces(registry)
+	reputationtypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry), nil
+}
@@ -0,0 +1,124 @@
+package client_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"speculod/client"
+	"speculod/testutil/network"
+	"speculod/testutil/sample"
+	predictiontypes "speculod/x/prediction/types"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+func TestClient(t *testing.T) {
+	cfg := network.DefaultConfig()
+
+	// Seed a market with a complete set of positions for the positions query
+	holder := sample.AccAddress()
+	var genesis predictiontypes.GenesisState
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[predictiontypes.ModuleName], &genesis))
+	genesis.Markets = append(genesis.Markets, predictiontypes.PredictionMarket{
+		Id:       0,
+		Creator:  holder,
+		Question: "Seeded?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: time.Now().Add(time.Hour).Unix(),
+		Status:   predictiontypes.MarketStatusOpen,
+	})
+	for i := range uint32(2) {
+		shares := sdk.NewInt64Coin("stake", 7)
+		genesis.Positions = append(genesis.Positions, predictiontypes.GenesisPosition{
+			OutcomeIndex: i,
+			Position:     predictiontypes.Position{MarketId: 0, Owner: holder, Amount: &shares},
+		})
+	}
+	genesis.MarketIdSeq = 1
+	bz, err := cfg.Codec.MarshalJSON(&genesis)
+	require.NoError(t, err)
+	cfg.GenesisState[predictiontypes.ModuleName] = bz
+
+	net := network.New(t, cfg)
+	val := net.Validators[0]
+	conn, err := grpc.NewClient(val.AppConfig.GRPC.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	c, err := client.New(ctx, conn, val.ClientCtx.Keyring,
+		client.WithGasPrices(cfg.MinGasPrices),
+		client.WithPollInterval(100*time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, cfg.ChainID, c.ChainID())
+
+	from := val.Moniker
+	deadline := time.Now().Add(5 * time.Second)
+	marketId, err := c.CreateMarket(ctx, from, predictiontypes.MsgCreateMarket{
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: deadline.Unix(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), marketId)
+	market, err := c.Prediction.Market(ctx, &predictiontypes.QueryMarketRequest{MarketId: marketId})
+	require.NoError(t, err)
+	require.Equal(t, val.Address.String(), market.Market.Creator)
+
+	// Orders of one key broadcast concurrently use consecutive sequences
+	var (
+		wg       sync.WaitGroup
+		orderIds = make([]uint64, 3)
+		errs     = make([]error, 3)
+	)
+	for i := range orderIds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := c.PostOrder(ctx, from, marketId, 0, "BUY", "0.4", sdk.NewInt64Coin("stake", 10))
+			if err == nil {
+				orderIds[i] = res.OrderId
+			}
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.ElementsMatch(t, []uint64{0, 1, 2}, orderIds)
+
+	require.NoError(t, c.CancelOrder(ctx, from, orderIds[0]))
+	order, err := c.Prediction.Order(ctx, &predictiontypes.QueryOrderRequest{OrderId: orderIds[0]})
+	require.NoError(t, err)
+	require.Equal(t, predictiontypes.ORDER_STATUS_CANCELLED, order.Order.Status)
+
+	// Invalid transactions are rejected by the gas estimation
+	require.ErrorContains(t, c.CancelOrder(ctx, from, 99), "not found")
+
+	positions, err := c.Positions(ctx, 0, holder)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	require.Equal(t, uint32(1), positions[1].OutcomeIndex)
+	require.Equal(t, int64(7), positions[1].Position.Amount.Amount.Int64())
+
+	// Votes are only accepted once the deadline has passed
+	for time.Now().Before(deadline.Add(time.Second)) {
+		require.NoError(t, net.WaitForNextBlock())
+	}
+	require.NoError(t, net.WaitForNextBlock())
+	nonce, err := c.CommitAndRevealVote(ctx, from, marketId, "Yes")
+	require.NoError(t, err)
+	reveals, err := c.Settlement.Reveals(ctx, &settlementtypes.QueryRevealsRequest{MarketId: marketId})
+	require.NoError(t, err)
+	require.Len(t, reveals.Reveals, 1)
+	require.Equal(t, nonce, reveals.Reveals[0].Nonce)
+	require.Equal(t, "Yes", reveals.Reveals[0].Vote)
+}
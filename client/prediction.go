@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"speculod/x/prediction/types"
+)
+
+// CreateMarket creates the market described by msg, signed by the key named
+// from, and returns its id. The creator of msg is set to the key's address.
+func (c *Client) CreateMarket(ctx context.Context, from string, msg types.MsgCreateMarket) (uint64, error) {
+	addr, err := c.Address(from)
+	if err != nil {
+		return 0, err
+	}
+	msg.Creator = addr.String()
+	res, err := c.BroadcastTx(ctx, from, &msg)
+	if err != nil {
+		return 0, err
+	}
+	var out types.MsgCreateMarketResponse
+	if err := decodeMsgResponse(res, 0, &out); err != nil {
+		return 0, err
+	}
+	return out.MarketId, nil
+}
+
+// PostOrder places a limit order on an outcome of a market. side is "BUY" or
+// "SELL" and price a decimal between 0 and 1. The response holds the order id
+// and the trades matched immediately.
+func (c *Client) PostOrder(ctx context.Context, from string, marketId uint64, outcomeIndex uint32, side, price string, amount sdk.Coin) (*types.MsgPostOrderResponse, error) {
+	addr, err := c.Address(from)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.BroadcastTx(ctx, from, &types.MsgPostOrder{
+		Creator:      addr.String(),
+		MarketId:     marketId,
+		OutcomeIndex: outcomeIndex,
+		Side:         side,
+		Price:        price,
+		Amount:       &amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out types.MsgPostOrderResponse
+	if err := decodeMsgResponse(res, 0, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelOrder cancels an open order of the key named from
+func (c *Client) CancelOrder(ctx context.Context, from string, orderId uint64) error {
+	addr, err := c.Address(from)
+	if err != nil {
+		return err
+	}
+	_, err = c.BroadcastTx(ctx, from, &types.MsgCancelOrder{Creator: addr.String(), OrderId: orderId})
+	return err
+}
+
+// Positions returns the positions held in a market, following the pagination
+// to the end. An empty owner returns the positions of all owners.
+func (c *Client) Positions(ctx context.Context, marketId uint64, owner string) ([]types.GenesisPosition, error) {
+	var (
+		positions []types.GenesisPosition
+		key       []byte
+	)
+	for {
+		res, err := c.Prediction.Positions(ctx, &types.QueryPositionsRequest{
+			MarketId:   marketId,
+			Owner:      owner,
+			Pagination: &query.PageRequest{Key: key},
+		})
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, res.Positions...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return positions, nil
+		}
+		key = res.Pagination.NextKey
+	}
+}
+
+// OpenOrders returns the orders of owner resting on the book of a market
+// outcome
+func (c *Client) OpenOrders(ctx context.Context, marketId uint64, outcomeIndex uint32, owner string) ([]types.Order, error) {
+	res, err := c.Prediction.Orders(ctx, &types.QueryOrdersRequest{MarketId: marketId, OutcomeIndex: outcomeIndex})
+	if err != nil {
+		return nil, err
+	}
+	var orders []types.Order
+	for _, order := range res.Orders {
+		if order.Creator == owner {
+			orders = append(orders, order)
+		}
+	}
+	return orders, nil
+}
+
+// Exposure returns the net position and resting orders of account on a
+// market outcome
+func (c *Client) Exposure(ctx context.Context, marketId uint64, outcomeIndex uint32, account string) (types.Exposure, error) {
+	res, err := c.Prediction.Exposure(ctx, &types.QueryExposureRequest{MarketId: marketId, OutcomeIndex: outcomeIndex, Account: account})
+	if err != nil {
+		return types.Exposure{}, err
+	}
+	return res.Exposure, nil
+}
+
+// UserOrders returns every order of owner, in all markets and statuses,
+// following the pagination to the end
+func (c *Client) UserOrders(ctx context.Context, owner string) ([]types.Order, error) {
+	var (
+		orders []types.Order
+		key    []byte
+	)
+	for {
+		res, err := c.Prediction.UserOrders(ctx, &types.QueryUserOrdersRequest{
+			User:       owner,
+			Pagination: &query.PageRequest{Key: key},
+		})
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, res.Orders...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return orders, nil
+		}
+		key = res.Pagination.NextKey
+	}
+}
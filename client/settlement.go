@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"speculod/x/settlement/types"
+)
+
+// nonceSize is the number of random bytes of a generated vote nonce
+const nonceSize = 32
+
+// CommitVote commits the hash of vote and nonce on a market
+func (c *Client) CommitVote(ctx context.Context, from string, marketId uint64, vote, nonce string) error {
+	addr, err := c.Address(from)
+	if err != nil {
+		return err
+	}
+	_, err = c.BroadcastTx(ctx, from, &types.MsgCommitVote{
+		Creator:    addr.String(),
+		MarketId:   marketId,
+		Commitment: types.VoteCommitment(vote, nonce),
+	})
+	return err
+}
+
+// RevealVote reveals a vote committed with CommitVote
+func (c *Client) RevealVote(ctx context.Context, from string, marketId uint64, vote, nonce string) error {
+	addr, err := c.Address(from)
+	if err != nil {
+		return err
+	}
+	_, err = c.BroadcastTx(ctx, from, &types.MsgRevealVote{
+		Creator:  addr.String(),
+		MarketId: marketId,
+		Vote:     vote,
+		Nonce:    nonce,
+	})
+	return err
+}
+
+// CommitAndRevealVote commits a vote with a random nonce and reveals it once
+// the commit is included. The nonce is returned whenever the commit went
+// through, so a failed reveal can be retried with RevealVote.
+func (c *Client) CommitAndRevealVote(ctx context.Context, from string, marketId uint64, vote string) (string, error) {
+	b := make([]byte, nonceSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	nonce := hex.EncodeToString(b)
+	if err := c.CommitVote(ctx, from, marketId, vote, nonce); err != nil {
+		return "", err
+	}
+	return nonce, c.RevealVote(ctx, from, marketId, vote, nonce)
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// account is the signing state of one key
+type account struct {
+	number   uint64
+	sequence uint64
+}
+
+// TxError is returned when a transaction is rejected by CheckTx or fails
+// during execution
+type TxError struct {
+	Response *sdk.TxResponse
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("tx %s failed with code %d (%s): %s",
+		e.Response.TxHash, e.Response.Code, e.Response.Codespace, e.Response.RawLog)
+}
+
+// BroadcastTx signs msgs with the key named from, estimates the gas by
+// simulation and broadcasts the transaction. It waits until the transaction
+// is included in a block and returns an error for failed transactions.
+//
+// Transactions of one key are signed with consecutive sequences without
+// waiting for the previous ones to be included, so BroadcastTx can be called
+// concurrently.
+func (c *Client) BroadcastTx(ctx context.Context, from string, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	hash, err := c.signAndBroadcast(ctx, from, msgs)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitForTx(ctx, hash)
+}
+
+// signAndBroadcast submits a transaction and returns its hash once it passed
+// CheckTx. The sequence is only advanced for accepted transactions.
+func (c *Client) signAndBroadcast(ctx context.Context, from string, msgs []sdk.Msg) (string, error) {
+	addr, err := c.Address(from)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	acc, err := c.account(ctx, addr)
+	if err != nil {
+		return "", err
+	}
+	txf := tx.Factory{}.
+		WithTxConfig(c.txConfig).
+		WithKeybase(c.keyring).
+		WithFromName(from).
+		WithChainID(c.chainID).
+		WithAccountNumber(acc.number).
+		WithSequence(acc.sequence).
+		WithGasPrices(c.gasPrices).
+		WithSimulateAndExecute(true).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	gas, err := c.EstimateGas(ctx, txf, msgs...)
+	if err != nil {
+		return "", err
+	}
+	builder, err := txf.WithGas(gas).BuildUnsignedTx(msgs...)
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Sign(ctx, txf, from, builder, true); err != nil {
+		return "", err
+	}
+	txBytes, err := c.txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.tx.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		// The tx may or may not have reached the mempool, refetch the sequence
+		delete(c.accounts, addr.String())
+		return "", err
+	}
+	if res.TxResponse.Code != 0 {
+		if res.TxResponse.Codespace == sdkerrors.RootCodespace && res.TxResponse.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			delete(c.accounts, addr.String())
+		}
+		return "", &TxError{Response: res.TxResponse}
+	}
+	acc.sequence++
+	return res.TxResponse.TxHash, nil
+}
+
+// account returns the cached signing state of addr, querying it on first use
+func (c *Client) account(ctx context.Context, addr sdk.AccAddress) (*account, error) {
+	if acc, ok := c.accounts[addr.String()]; ok {
+		return acc, nil
+	}
+	res, err := c.auth.Account(ctx, &authtypes.QueryAccountRequest{Address: addr.String()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "query account %s", addr)
+	}
+	var info sdk.AccountI
+	if err := c.codec.UnpackAny(res.Account, &info); err != nil {
+		return nil, err
+	}
+	acc := &account{number: info.GetAccountNumber(), sequence: info.GetSequence()}
+	c.accounts[addr.String()] = acc
+	return acc, nil
+}
+
+// EstimateGas simulates msgs signed with txf and returns the gas used scaled
+// by the gas adjustment
+func (c *Client) EstimateGas(ctx context.Context, txf tx.Factory, msgs ...sdk.Msg) (uint64, error) {
+	txBytes, err := txf.BuildSimTx(msgs...)
+	if err != nil {
+		return 0, err
+	}
+	res, err := c.tx.Simulate(ctx, &txtypes.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, errors.Wrap(err, "simulate tx")
+	}
+	return uint64(math.Ceil(c.gasAdjustment * float64(res.GasInfo.GasUsed))), nil
+}
+
+// WaitForTx polls the node until the transaction with the given hash is
+// included in a block or ctx is done
+func (c *Client) WaitForTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	for {
+		res, err := c.tx.GetTx(ctx, &txtypes.GetTxRequest{Hash: hash})
+		switch {
+		case err == nil && res.TxResponse.Code != 0:
+			return nil, &TxError{Response: res.TxResponse}
+		case err == nil:
+			return res.TxResponse, nil
+		case status.Code(err) != codes.NotFound:
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "waiting for tx %s", hash)
+		case <-ticker.C:
+		}
+	}
+}
+
+// decodeMsgResponse unmarshals the response of the i-th message of an
+// included transaction into out
+func decodeMsgResponse(res *sdk.TxResponse, i int, out gogoproto.Message) error {
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return err
+	}
+	var msgData sdk.TxMsgData
+	if err := msgData.Unmarshal(data); err != nil {
+		return err
+	}
+	if i >= len(msgData.MsgResponses) {
+		return fmt.Errorf("tx %s has %d message responses, want at least %d", res.TxHash, len(msgData.MsgResponses), i+1)
+	}
+	if typeURL := "/" + gogoproto.MessageName(out); msgData.MsgResponses[i].TypeUrl != typeURL {
+		return fmt.Errorf("tx %s: message response %d is %s, want %s", res.TxHash, i, msgData.MsgResponses[i].TypeUrl, typeURL)
+	}
+	return gogoproto.Unmarshal(msgData.MsgResponses[i].Value, out)
+}
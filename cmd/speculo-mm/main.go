@@ -0,0 +1,170 @@
+// Command speculo-mm is a reference market maker quoting two-sided orders
+// around a fair value on the configured Speculo markets.
+//
+// Like the start command of a node, it reads config/mm.toml in its home
+// directory, writing the default one on the first start. Flags and
+// SPECULO_MM_ environment variables override the file, e.g. --from or
+// SPECULO_MM_FAIR_VALUE_SOURCE. Quotes are cancelled on shutdown.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"cosmossdk.io/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"speculod/client"
+	"speculod/marketmaker"
+)
+
+const (
+	// EnvPrefix is the prefix of the environment variables overriding the
+	// configuration
+	EnvPrefix = "SPECULO_MM"
+
+	flagHome            = "home"
+	flagGRPCAddress     = "grpc-address"
+	flagChainID         = "chain-id"
+	flagFrom            = "from"
+	flagKeyringBackend  = "keyring-backend"
+	flagKeyringDir      = "keyring-dir"
+	flagGasPrices       = "gas-prices"
+	flagGasAdjustment   = "gas-adjustment"
+	flagRefreshInterval = "refresh-interval"
+	flagFairValueSource = "fair-value.source"
+	flagFairValueValue  = "fair-value.value"
+	flagFairValueFile   = "fair-value.file"
+	flagTWAPWindow      = "fair-value.twap-window"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := NewRootCmd().ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// NewRootCmd returns the root command of the market maker
+func NewRootCmd() *cobra.Command {
+	home, _ := os.UserHomeDir()
+	cmd := &cobra.Command{
+		Use:          "speculo-mm",
+		Short:        "Reference market maker for Speculo prediction markets",
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().String(flagHome, filepath.Join(home, ".speculo-mm"), "directory of the configuration and keyring")
+	cmd.AddCommand(StartCmd())
+	return cmd
+}
+
+// StartCmd quotes the configured markets until interrupted
+func StartCmd() *cobra.Command {
+	var config marketmaker.Config
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Quote the configured markets until interrupted",
+		Args:  cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, _ []string) (err error) {
+			config, err = InterceptConfig(cmd)
+			return err
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			logger := log.NewLogger(cmd.OutOrStdout())
+
+			conn, err := grpc.NewClient(config.GRPCAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			kr, err := client.NewKeyring(config.KeyringBackend, config.KeyringDir, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			opts := []client.Option{client.WithGasPrices(config.GasPrices), client.WithGasAdjustment(config.GasAdjustment)}
+			if config.ChainID != "" {
+				opts = append(opts, client.WithChainID(config.ChainID))
+			}
+			c, err := client.New(ctx, conn, kr, opts...)
+			if err != nil {
+				return fmt.Errorf("connect to %s: %w", config.GRPCAddress, err)
+			}
+
+			source, err := marketmaker.NewFairValueSource(config.FairValue, c.Prediction)
+			if err != nil {
+				return err
+			}
+			bot, err := marketmaker.New(c, config, source, logger)
+			if err != nil {
+				return err
+			}
+			logger.Info("quoting", "markets", len(config.Markets), "fair-value", config.FairValue.Source)
+			return bot.Run(ctx)
+		},
+	}
+
+	d := marketmaker.DefaultConfig()
+	f := cmd.Flags()
+	f.String(flagGRPCAddress, d.GRPCAddress, "gRPC address of the node")
+	f.String(flagChainID, d.ChainID, "chain ID, queried from the node when empty")
+	f.String(flagFrom, d.From, "name of the key quoting the markets")
+	f.String(flagKeyringBackend, d.KeyringBackend, "keyring backend (os|file|kwallet|pass|test|memory)")
+	f.String(flagKeyringDir, d.KeyringDir, "keyring directory, the home directory when empty")
+	f.String(flagGasPrices, d.GasPrices, "gas prices the fees are paid with")
+	f.Float64(flagGasAdjustment, d.GasAdjustment, "factor applied to simulated gas")
+	f.Duration(flagRefreshInterval, d.RefreshInterval, "how often the quotes are refreshed")
+	f.String(flagFairValueSource, d.FairValue.Source, "fair value source (constant|file|twap)")
+	f.String(flagFairValueValue, d.FairValue.Value, "constant fair value, and fallback of the twap source")
+	f.String(flagFairValueFile, d.FairValue.File, "JSON file of the file source")
+	f.Duration(flagTWAPWindow, d.FairValue.TWAPWindow, "averaging window of the twap source")
+	return cmd
+}
+
+// InterceptConfig reads config/mm.toml in the home directory, writing the
+// default configuration first if it does not exist, and applies the
+// environment and flag overrides, in the order of precedence of viper.
+func InterceptConfig(cmd *cobra.Command) (marketmaker.Config, error) {
+	v := viper.New()
+	if err := v.BindPFlags(cmd.Flags()); err != nil {
+		return marketmaker.Config{}, err
+	}
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	v.AutomaticEnv()
+
+	home := v.GetString(flagHome)
+	path := filepath.Join(home, "config", "mm.toml")
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		if err := marketmaker.WriteConfigFile(path, marketmaker.DefaultConfig()); err != nil {
+			return marketmaker.Config{}, err
+		}
+	}
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return marketmaker.Config{}, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	config := marketmaker.DefaultConfig()
+	if err := v.Unmarshal(&config); err != nil {
+		return marketmaker.Config{}, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if config.KeyringDir == "" {
+		config.KeyringDir = home
+	}
+	if err := config.Validate(); err != nil {
+		return marketmaker.Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"speculod/marketmaker"
+)
+
+func TestInterceptConfig(t *testing.T) {
+	home := t.TempDir()
+	path := filepath.Join(home, "config", "mm.toml")
+	intercept := func(args ...string) (marketmaker.Config, error) {
+		cmd := StartCmd()
+		cmd.Flags().String(flagHome, home, "")
+		require.NoError(t, cmd.ParseFlags(args))
+		return InterceptConfig(cmd)
+	}
+
+	// The first start writes the default configuration, which quotes nothing
+	_, err := intercept()
+	require.ErrorContains(t, err, "from must name the key")
+	require.FileExists(t, path)
+	_, err = intercept("--from", "mm")
+	require.ErrorContains(t, err, "no market to quote")
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString(`
+[[markets]]
+market-id = 3
+outcome-index = 1
+size = "25"
+`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// Flags override the environment, which overrides the file
+	t.Setenv("SPECULO_MM_FROM", "env-key")
+	t.Setenv("SPECULO_MM_FAIR_VALUE_SOURCE", "twap")
+	t.Setenv("SPECULO_MM_REFRESH_INTERVAL", "3s")
+	config, err := intercept("--fair-value.value", "0.4", "--refresh-interval", "5s")
+	require.NoError(t, err)
+	require.Equal(t, "env-key", config.From)
+	require.Equal(t, marketmaker.SourceTWAP, config.FairValue.Source)
+	require.Equal(t, "0.4", config.FairValue.Value)
+	require.Equal(t, time.Hour, config.FairValue.TWAPWindow)
+	require.Equal(t, 5*time.Second, config.RefreshInterval)
+	require.Equal(t, home, config.KeyringDir)
+	require.Len(t, config.Markets, 1)
+	require.Equal(t, uint64(3), config.Markets[0].MarketId)
+	require.Equal(t, uint32(1), config.Markets[0].OutcomeIndex)
+	require.Equal(t, "25", config.Markets[0].Size)
+	require.Equal(t, "0.04", config.Markets[0].Spread)
+}
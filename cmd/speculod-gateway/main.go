@@ -0,0 +1,85 @@
+// Command speculod-gateway serves the market, order, vote and reputation
+// events of a Speculo node as the WebSocket feed described in the README.
+//
+// Clients connect to /websocket and narrow the feed with the type,
+// market_id, outcome_index and address query parameters.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"cosmossdk.io/log"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/spf13/cobra"
+
+	"speculod/gateway"
+)
+
+const (
+	flagNode   = "node"
+	flagListen = "listen"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := NewRootCmd().ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// NewRootCmd returns the command running the gateway
+func NewRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "speculod-gateway",
+		Short:        "Serve the Speculo module events as a WebSocket feed",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			node, _ := cmd.Flags().GetString(flagNode)
+			listen, _ := cmd.Flags().GetString(flagListen)
+
+			client, err := rpchttp.New(node, "/websocket")
+			if err != nil {
+				return err
+			}
+			if err := client.Start(); err != nil {
+				return fmt.Errorf("connect to %s: %w", node, err)
+			}
+			defer func() { _ = client.Stop() }()
+
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+			g := gateway.New(log.NewLogger(cmd.ErrOrStderr()))
+			mux := http.NewServeMux()
+			mux.Handle("/websocket", g)
+			srv := &http.Server{Addr: listen, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+			runErr := make(chan error, 1)
+			go func() {
+				runErr <- g.Run(ctx, client)
+				shutdown, cancelShutdown := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancelShutdown()
+				_ = srv.Shutdown(shutdown)
+			}()
+
+			cmd.Printf("serving on %s\n", listen)
+			if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			cancel()
+			return <-runErr
+		},
+	}
+	cmd.Flags().String(flagNode, "tcp://localhost:26657", "CometBFT RPC address of the node")
+	cmd.Flags().String(flagListen, "localhost:8091", "address to listen on")
+	return cmd
+}
@@ -0,0 +1,120 @@
+// Command speculod-indexer materializes the markets, orders, trades, candles,
+// votes and reputation changes of a Speculo chain into SQLite or Postgres and
+// serves their history over REST.
+//
+// Blocks are indexed live by a node started with indexer.dsn set in its
+// app.toml, or with the sync command from the data directory of a stopped
+// node. Both resume from the last indexed block.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"speculod/app"
+	"speculod/indexer"
+)
+
+const (
+	flagDB        = "db"
+	flagHome      = "home"
+	flagDBBackend = "db-backend"
+	flagListen    = "listen"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := NewRootCmd().ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// NewRootCmd returns the root command of the indexer
+func NewRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "speculod-indexer",
+		Short:        "Index Speculo markets, trades and votes into SQL and serve their history",
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().String(flagDB, "speculod-indexer.db", "SQLite database path or postgres:// URL")
+	cmd.AddCommand(SyncCmd(), ServeCmd())
+	return cmd
+}
+
+// SyncCmd indexes the blocks stored by a stopped node
+func SyncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Index the blocks in the data directory of a stopped node",
+		Long: `Index the blocks in the data directory of a stopped node, from the block
+after the last indexed one. The node must keep its FinalizeBlock responses
+(storage.discard_abci_responses = false in config.toml).`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			idx, err := openIndexer(cmd)
+			if err != nil {
+				return err
+			}
+			defer idx.Close()
+
+			home, _ := cmd.Flags().GetString(flagHome)
+			backend, _ := cmd.Flags().GetString(flagDBBackend)
+			height, err := idx.SyncDataDir(cmd.Context(), home, backend)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("indexed up to block %d\n", height)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagHome, app.DefaultNodeHome, "home directory of the node")
+	cmd.Flags().String(flagDBBackend, "goleveldb", "database backend of the node")
+	return cmd
+}
+
+// ServeCmd serves the REST history API
+func ServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the indexed history over REST",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			idx, err := openIndexer(cmd)
+			if err != nil {
+				return err
+			}
+			defer idx.Close()
+
+			listen, _ := cmd.Flags().GetString(flagListen)
+			srv := &http.Server{Addr: listen, Handler: idx.Handler(), ReadHeaderTimeout: 10 * time.Second}
+			go func() {
+				<-cmd.Context().Done()
+				shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_ = srv.Shutdown(shutdown)
+			}()
+			cmd.Printf("serving on %s\n", listen)
+			if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagListen, "localhost:8090", "address to listen on")
+	return cmd
+}
+
+func openIndexer(cmd *cobra.Command) (*indexer.Indexer, error) {
+	dsn, _ := cmd.Flags().GetString(flagDB)
+	return indexer.Open(dsn)
+}
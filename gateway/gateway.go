@@ -0,0 +1,267 @@
+// Package gateway serves the typed events of the custom modules as the JSON
+// WebSocket feed described in the README. Blocks are read from the event
+// subscription of a CometBFT node, or published by the caller, and every
+// module event is translated into a {"type", "data"} message sent to the
+// subscribers whose filter it matches.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/gorilla/websocket"
+
+	"speculod/indexer"
+)
+
+const (
+	// SubscriberBufferSize is the number of messages buffered per subscriber.
+	// A subscriber that falls further behind is disconnected.
+	SubscriberBufferSize = 256
+
+	writeTimeout = 10 * time.Second
+	subscriber   = "speculod-gateway"
+)
+
+// Filter selects the messages sent to a subscriber. Empty fields match every
+// message; a set field only matches the messages carrying that key, so a
+// market filter drops the reputation messages.
+type Filter struct {
+	Types        []string
+	MarketId     *uint64
+	OutcomeIndex *uint32
+	Address      string
+}
+
+// ParseFilter reads a filter from the type, market_id, outcome_index and
+// address query parameters. Types are comma separated.
+func ParseFilter(r *http.Request) (Filter, error) {
+	var f Filter
+	q := r.URL.Query()
+	for _, types := range q["type"] {
+		for _, typ := range strings.Split(types, ",") {
+			if typ != "" {
+				f.Types = append(f.Types, typ)
+			}
+		}
+	}
+	if v := q.Get("market_id"); v != "" {
+		marketId, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return Filter{}, fmt.Errorf("invalid market_id %q", v)
+		}
+		f.MarketId = &marketId
+	}
+	if v := q.Get("outcome_index"); v != "" {
+		outcomeIndex, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return Filter{}, fmt.Errorf("invalid outcome_index %q", v)
+		}
+		o := uint32(outcomeIndex)
+		f.OutcomeIndex = &o
+	}
+	f.Address = q.Get("address")
+	return f, nil
+}
+
+// Match reports whether the message passes the filter
+func (f Filter) Match(m Message) bool {
+	if len(f.Types) > 0 && !contains(f.Types, m.Type) {
+		return false
+	}
+	if f.MarketId != nil && (m.marketId == nil || *m.marketId != *f.MarketId) {
+		return false
+	}
+	if f.OutcomeIndex != nil && (m.outcomeIndex == nil || *m.outcomeIndex != *f.OutcomeIndex) {
+		return false
+	}
+	return f.Address == "" || contains(m.addresses, f.Address)
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+type gatewaySubscriber struct {
+	filter   Filter
+	messages chan Message
+}
+
+// Gateway fans the translated block events out to its WebSocket subscribers
+type Gateway struct {
+	logger   log.Logger
+	upgrader websocket.Upgrader
+
+	mu          sync.Mutex
+	subscribers map[*gatewaySubscriber]struct{}
+}
+
+// New returns a gateway without subscribers. Browsers are allowed to connect
+// from any origin.
+func New(logger log.Logger) *Gateway {
+	return &Gateway{
+		logger: logger,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+		subscribers: make(map[*gatewaySubscriber]struct{}),
+	}
+}
+
+// Publish sends the messages of a committed block to the matching
+// subscribers. Events that cannot be decoded are logged and skipped.
+func (g *Gateway) Publish(block indexer.Block) {
+	var messages []Message
+	for _, event := range block.Events {
+		m, ok, err := Translate(block.Height, event)
+		if err != nil {
+			g.logger.Error("failed to decode event", "height", block.Height, "type", event.Type, "err", err)
+			continue
+		}
+		if ok {
+			messages = append(messages, m)
+		}
+	}
+	if len(messages) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for sub := range g.subscribers {
+		g.sendLocked(sub, messages)
+	}
+}
+
+// sendLocked queues the matching messages for sub, or drops it once its
+// buffer is full
+func (g *Gateway) sendLocked(sub *gatewaySubscriber, messages []Message) {
+	for _, m := range messages {
+		if !sub.filter.Match(m) {
+			continue
+		}
+		select {
+		case sub.messages <- m:
+		default:
+			g.removeLocked(sub)
+			return
+		}
+	}
+}
+
+func (g *Gateway) subscribe(filter Filter) *gatewaySubscriber {
+	sub := &gatewaySubscriber{filter: filter, messages: make(chan Message, SubscriberBufferSize)}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (g *Gateway) unsubscribe(sub *gatewaySubscriber) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.removeLocked(sub)
+}
+
+// removeLocked closes the channel of a subscriber, which ends its connection
+func (g *Gateway) removeLocked(sub *gatewaySubscriber) {
+	if _, ok := g.subscribers[sub]; ok {
+		delete(g.subscribers, sub)
+		close(sub.messages)
+	}
+}
+
+// ServeHTTP upgrades the request to a WebSocket streaming the messages that
+// match the filter of its query parameters. Messages sent by the client are
+// ignored.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	filter, err := ParseFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Subscribing before the upgrade completes means the messages of the
+	// blocks published once the client is connected are not missed
+	sub := g.subscribe(filter)
+	defer g.unsubscribe(sub)
+	conn, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	// The read loop handles the control frames and notices the client leaving
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case m, ok := <-sub.messages:
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscriber too slow"),
+					time.Now().Add(writeTimeout))
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := conn.WriteJSON(m); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Run publishes the blocks of the node behind client until ctx is done or the
+// subscription ends. client must be started.
+func (g *Gateway) Run(ctx context.Context, client cmtclient.EventsClient) error {
+	events, err := client.Subscribe(ctx, subscriber, cmttypes.EventQueryNewBlock.String(), SubscriberBufferSize)
+	if err != nil {
+		return fmt.Errorf("subscribe to new blocks: %w", err)
+	}
+	defer func() {
+		_ = client.UnsubscribeAll(context.Background(), subscriber)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return errors.New("new block subscription closed")
+			}
+			data, ok := event.Data.(cmttypes.EventDataNewBlock)
+			if !ok || data.Block == nil {
+				continue
+			}
+			g.Publish(indexer.Block{
+				Height: data.Block.Height,
+				Time:   data.Block.Time,
+				Events: indexer.BlockEvents(&data.ResultFinalizeBlock),
+			})
+		}
+	}
+}
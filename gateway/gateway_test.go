@@ -0,0 +1,161 @@
+package gateway_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"speculod/gateway"
+	"speculod/indexer"
+	predictiontypes "speculod/x/prediction/types"
+	reputationtypes "speculod/x/reputation/types"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+// received is a feed message with its data left undecoded
+type received struct {
+	Type   string          `json:"type"`
+	Height string          `json:"height"`
+	Data   json.RawMessage `json:"data"`
+}
+
+func block(t *testing.T, height int64, msgs ...proto.Message) indexer.Block {
+	t.Helper()
+	b := indexer.Block{Height: height, Time: time.Unix(1_000, 0)}
+	for _, msg := range msgs {
+		event, err := sdk.TypedEventToEvent(msg)
+		require.NoError(t, err)
+		b.Events = append(b.Events, abci.Event(event))
+	}
+	return b
+}
+
+// dial connects to the feed of srv with the given query parameters
+func dial(t *testing.T, srv *httptest.Server, query string) *websocket.Conn {
+	t.Helper()
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket?"+query, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// read reads the next n messages of conn
+func read(t *testing.T, conn *websocket.Conn, n int) []received {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	messages := make([]received, n)
+	for i := range messages {
+		require.NoError(t, conn.ReadJSON(&messages[i]))
+	}
+	return messages
+}
+
+func types(messages []received) []string {
+	var types []string
+	for _, m := range messages {
+		types = append(types, m.Type)
+	}
+	return types
+}
+
+func TestGateway(t *testing.T) {
+	g := gateway.New(log.NewNopLogger())
+	mux := http.NewServeMux()
+	mux.Handle("/websocket", g)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	all := dial(t, srv, "")
+	market := dial(t, srv, "market_id=1")
+	outcome := dial(t, srv, "market_id=1&outcome_index=1")
+	bob := dial(t, srv, "address=bob&type=order.filled,reputation.adjusted")
+
+	amount, filled := sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("stake", 40)
+	tradeAmount := sdk.NewInt64Coin("stake", 40)
+	g.Publish(block(t, 7,
+		&predictiontypes.EventMarketCreated{MarketId: 1, Creator: "carol", Question: "Rain?", Outcomes: []string{"Yes", "No"}, Deadline: 5_000},
+		&predictiontypes.EventOrderPosted{Order: predictiontypes.Order{
+			Id: 3, MarketId: 1, OutcomeIndex: 1, Creator: "alice", Side: predictiontypes.ORDER_SIDE_BUY, Price: "0.6",
+			Amount: &amount, FilledAmount: &filled, Status: predictiontypes.ORDER_STATUS_PARTIALLY_FILLED, CreatedAt: 1_000,
+		}},
+		&predictiontypes.EventTrade{Trade: predictiontypes.Trade{
+			TradeId: 9, MarketId: 1, OutcomeIndex: 1, Buyer: "alice", Seller: "bob", Price: "0.6",
+			Amount: &tradeAmount, Timestamp: 1_000, BuyOrderId: 3, SellOrderId: 2,
+		}},
+		&predictiontypes.EventMarketClosed{MarketId: 1, ClosedAt: 5_000},
+		&settlementtypes.EventVoteRevealed{MarketId: 1, Voter: "bob", Vote: "Yes"},
+		&settlementtypes.EventOutcomeFinalized{MarketId: 1, Outcome: "Yes", TotalVotes: 1, OutcomeWeight: 85},
+		&reputationtypes.EventReputationAdjusted{Address: "bob", Adjustment: 1, PreviousScore: "0", NewScore: "1"},
+	))
+	// Market closures are not part of the feed, the vote of market 2 only
+	// reaches the unfiltered connection
+	g.Publish(block(t, 8, &predictiontypes.EventMarketClosed{MarketId: 2, ClosedAt: 5_000}))
+	g.Publish(block(t, 9, &settlementtypes.EventVoteCommitted{MarketId: 2, Voter: "bob"}))
+
+	messages := read(t, all, 7)
+	require.Equal(t, []string{
+		gateway.TypeMarketCreated, gateway.TypeOrderPosted, gateway.TypeOrderFilled, gateway.TypeVoteRevealed,
+		gateway.TypeOutcomeFinalized, gateway.TypeReputationAdjusted, gateway.TypeVoteCommitted,
+	}, types(messages))
+	require.Equal(t, "7", messages[0].Height)
+	require.JSONEq(t, `{
+		"market": {"id": "1", "question": "Rain?", "outcomes": ["Yes", "No"], "groupId": "", "deadline": "5000", "status": "ACTIVE", "creator": "carol"},
+		"marketId": "1"
+	}`, string(messages[0].Data))
+	require.JSONEq(t, `{
+		"order": {"id": "3", "marketId": "1", "outcomeIndex": 1, "side": "BUY", "price": "0.6", "quantity": "100",
+			"filledQuantity": "40", "status": "PARTIAL", "creator": "alice", "timestamp": "1000"},
+		"marketId": "1",
+		"outcomeIndex": 1
+	}`, string(messages[1].Data))
+	require.JSONEq(t, `{
+		"trade": {"id": "9", "buyer": "alice", "seller": "bob", "buyOrderId": "3", "sellOrderId": "2", "price": "0.6", "quantity": "40", "timestamp": "1000"},
+		"marketId": "1",
+		"outcomeIndex": 1
+	}`, string(messages[2].Data))
+	require.JSONEq(t, `{"marketId": "1", "voter": "bob", "vote": "Yes"}`, string(messages[3].Data))
+	require.JSONEq(t, `{"marketId": "1", "outcome": "Yes", "totalVotes": 1, "outcomeWeight": "85"}`, string(messages[4].Data))
+	require.JSONEq(t, `{"address": "bob", "groupId": "", "adjustment": 1, "previousScore": "0", "newScore": "1"}`, string(messages[5].Data))
+
+	require.Equal(t, []string{
+		gateway.TypeMarketCreated, gateway.TypeOrderPosted, gateway.TypeOrderFilled, gateway.TypeVoteRevealed, gateway.TypeOutcomeFinalized,
+	}, types(read(t, market, 5)))
+	require.Equal(t, []string{gateway.TypeOrderPosted, gateway.TypeOrderFilled}, types(read(t, outcome, 2)))
+	require.Equal(t, []string{gateway.TypeOrderFilled, gateway.TypeReputationAdjusted}, types(read(t, bob, 2)))
+
+	// Nothing else was queued for the filtered connections
+	g.Publish(block(t, 10, &predictiontypes.EventMarketCreated{MarketId: 1, Creator: "carol"}))
+	require.Equal(t, []string{gateway.TypeMarketCreated}, types(read(t, market, 1)))
+	require.NoError(t, bob.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+	_, _, err := bob.ReadMessage()
+	require.Error(t, err)
+}
+
+func TestParseFilter(t *testing.T) {
+	for _, query := range []string{"market_id=x", "outcome_index=-1", "outcome_index=4294967296"} {
+		_, err := gateway.ParseFilter(httptest.NewRequest(http.MethodGet, "/websocket?"+query, nil))
+		require.Error(t, err, query)
+	}
+	f, err := gateway.ParseFilter(httptest.NewRequest(http.MethodGet, "/websocket?type=a,b&type=c&market_id=4&address=bob", nil))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, f.Types)
+	require.Equal(t, uint64(4), *f.MarketId)
+	require.Nil(t, f.OutcomeIndex)
+	require.Equal(t, "bob", f.Address)
+
+	// Invalid filters are refused before the upgrade
+	g := gateway.New(log.NewNopLogger())
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/websocket?market_id=x", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,258 @@
+package gateway
+
+import (
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	predictiontypes "speculod/x/prediction/types"
+	reputationtypes "speculod/x/reputation/types"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+// Message types of the WebSocket feed
+const (
+	TypeMarketCreated      = "market.created"
+	TypeOrderPosted        = "order.posted"
+	TypeOrderFilled        = "order.filled"
+	TypeOrderCancelled     = "order.cancelled"
+	TypeVoteCommitted      = "vote.committed"
+	TypeVoteRevealed       = "vote.revealed"
+	TypeOutcomeFinalized   = "outcome.finalized"
+	TypeReputationAdjusted = "reputation.adjusted"
+)
+
+// Message is a WebSocket feed message. The unexported fields are the keys the
+// subscriber filters are matched against.
+type Message struct {
+	Type   string `json:"type"`
+	Height int64  `json:"height,string"`
+	Data   any    `json:"data"`
+
+	marketId     *uint64
+	outcomeIndex *uint32
+	addresses    []string
+}
+
+// Market is the market of a market.created message
+type Market struct {
+	Id       uint64   `json:"id,string"`
+	Question string   `json:"question"`
+	Outcomes []string `json:"outcomes"`
+	GroupId  string   `json:"groupId"`
+	Deadline int64    `json:"deadline,string"`
+	Status   string   `json:"status"`
+	Creator  string   `json:"creator"`
+}
+
+// Order is the order of an order.posted message
+type Order struct {
+	Id             uint64 `json:"id,string"`
+	MarketId       uint64 `json:"marketId,string"`
+	OutcomeIndex   uint32 `json:"outcomeIndex"`
+	Side           string `json:"side"`
+	Price          string `json:"price"`
+	Quantity       string `json:"quantity"`
+	FilledQuantity string `json:"filledQuantity"`
+	Status         string `json:"status"`
+	Creator        string `json:"creator"`
+	Timestamp      int64  `json:"timestamp,string"`
+}
+
+// Trade is the fill of an order.filled message
+type Trade struct {
+	Id          uint64 `json:"id,string"`
+	Buyer       string `json:"buyer"`
+	Seller      string `json:"seller"`
+	BuyOrderId  uint64 `json:"buyOrderId,string"`
+	SellOrderId uint64 `json:"sellOrderId,string"`
+	Price       string `json:"price"`
+	Quantity    string `json:"quantity"`
+	Timestamp   int64  `json:"timestamp,string"`
+}
+
+// MarketCreatedData is the data of a market.created message
+type MarketCreatedData struct {
+	Market   Market `json:"market"`
+	MarketId uint64 `json:"marketId,string"`
+}
+
+// OrderPostedData is the data of an order.posted message
+type OrderPostedData struct {
+	Order        Order  `json:"order"`
+	MarketId     uint64 `json:"marketId,string"`
+	OutcomeIndex uint32 `json:"outcomeIndex"`
+}
+
+// OrderFilledData is the data of an order.filled message
+type OrderFilledData struct {
+	Trade        Trade  `json:"trade"`
+	MarketId     uint64 `json:"marketId,string"`
+	OutcomeIndex uint32 `json:"outcomeIndex"`
+}
+
+// OrderCancelledData is the data of an order.cancelled message
+type OrderCancelledData struct {
+	OrderId           uint64 `json:"orderId,string"`
+	MarketId          uint64 `json:"marketId,string"`
+	OutcomeIndex      uint32 `json:"outcomeIndex"`
+	Creator           string `json:"creator"`
+	RemainingQuantity string `json:"remainingQuantity"`
+}
+
+// VoteData is the data of the vote.committed and vote.revealed messages. The
+// vote is only set once revealed.
+type VoteData struct {
+	MarketId uint64 `json:"marketId,string"`
+	Voter    string `json:"voter"`
+	Vote     string `json:"vote,omitempty"`
+}
+
+// OutcomeFinalizedData is the data of an outcome.finalized message
+type OutcomeFinalizedData struct {
+	MarketId      uint64 `json:"marketId,string"`
+	Outcome       string `json:"outcome"`
+	TotalVotes    uint32 `json:"totalVotes"`
+	OutcomeWeight int64  `json:"outcomeWeight,string"`
+}
+
+// ReputationAdjustedData is the data of a reputation.adjusted message
+type ReputationAdjustedData struct {
+	Address       string `json:"address"`
+	GroupId       string `json:"groupId"`
+	Adjustment    int64  `json:"adjustment"`
+	PreviousScore string `json:"previousScore"`
+	NewScore      string `json:"newScore"`
+}
+
+// Translate converts a typed module event into a feed message. It returns
+// false for the events that are not part of the feed.
+func Translate(height int64, event abci.Event) (Message, bool, error) {
+	if !strings.HasPrefix(event.Type, "speculod.") || gogoproto.MessageType(event.Type) == nil {
+		return Message{}, false, nil
+	}
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return Message{}, false, err
+	}
+
+	m := Message{Height: height}
+	switch e := msg.(type) {
+	case *predictiontypes.EventMarketCreated:
+		m.Type = TypeMarketCreated
+		m.Data = MarketCreatedData{
+			Market: Market{
+				Id:       e.MarketId,
+				Question: e.Question,
+				Outcomes: e.Outcomes,
+				GroupId:  e.GroupId,
+				Deadline: e.Deadline,
+				Status:   "ACTIVE",
+				Creator:  e.Creator,
+			},
+			MarketId: e.MarketId,
+		}
+		m.marketId, m.addresses = &e.MarketId, []string{e.Creator}
+
+	case *predictiontypes.EventOrderPosted:
+		m.Type = TypeOrderPosted
+		m.Data = OrderPostedData{Order: order(e.Order), MarketId: e.Order.MarketId, OutcomeIndex: e.Order.OutcomeIndex}
+		m.marketId, m.outcomeIndex, m.addresses = &e.Order.MarketId, &e.Order.OutcomeIndex, []string{e.Order.Creator}
+
+	case *predictiontypes.EventTrade:
+		t := e.Trade
+		m.Type = TypeOrderFilled
+		m.Data = OrderFilledData{
+			Trade: Trade{
+				Id:          t.TradeId,
+				Buyer:       t.Buyer,
+				Seller:      t.Seller,
+				BuyOrderId:  t.BuyOrderId,
+				SellOrderId: t.SellOrderId,
+				Price:       t.Price,
+				Quantity:    coinAmount(t.Amount),
+				Timestamp:   t.Timestamp,
+			},
+			MarketId:     t.MarketId,
+			OutcomeIndex: t.OutcomeIndex,
+		}
+		m.marketId, m.outcomeIndex, m.addresses = &t.MarketId, &t.OutcomeIndex, []string{t.Buyer, t.Seller}
+
+	case *predictiontypes.EventOrderCancelled:
+		m.Type = TypeOrderCancelled
+		m.Data = OrderCancelledData{
+			OrderId:           e.OrderId,
+			MarketId:          e.MarketId,
+			OutcomeIndex:      e.OutcomeIndex,
+			Creator:           e.Creator,
+			RemainingQuantity: coinAmount(&e.Remaining),
+		}
+		m.marketId, m.outcomeIndex, m.addresses = &e.MarketId, &e.OutcomeIndex, []string{e.Creator}
+
+	case *settlementtypes.EventVoteCommitted:
+		m.Type = TypeVoteCommitted
+		m.Data = VoteData{MarketId: e.MarketId, Voter: e.Voter}
+		m.marketId, m.addresses = &e.MarketId, []string{e.Voter}
+
+	case *settlementtypes.EventVoteRevealed:
+		m.Type = TypeVoteRevealed
+		m.Data = VoteData{MarketId: e.MarketId, Voter: e.Voter, Vote: e.Vote}
+		m.marketId, m.addresses = &e.MarketId, []string{e.Voter}
+
+	case *settlementtypes.EventOutcomeFinalized:
+		m.Type = TypeOutcomeFinalized
+		m.Data = OutcomeFinalizedData{MarketId: e.MarketId, Outcome: e.Outcome, TotalVotes: e.TotalVotes, OutcomeWeight: e.OutcomeWeight}
+		m.marketId = &e.MarketId
+
+	case *reputationtypes.EventReputationAdjusted:
+		m.Type = TypeReputationAdjusted
+		m.Data = ReputationAdjustedData{
+			Address:       e.Address,
+			GroupId:       e.GroupId,
+			Adjustment:    e.Adjustment,
+			PreviousScore: e.PreviousScore,
+			NewScore:      e.NewScore,
+		}
+		m.addresses = []string{e.Address}
+
+	default:
+		return Message{}, false, nil
+	}
+	return m, true, nil
+}
+
+func order(o predictiontypes.Order) Order {
+	return Order{
+		Id:             o.Id,
+		MarketId:       o.MarketId,
+		OutcomeIndex:   o.OutcomeIndex,
+		Side:           strings.TrimPrefix(o.Side.String(), "ORDER_SIDE_"),
+		Price:          o.Price,
+		Quantity:       coinAmount(o.Amount),
+		FilledQuantity: coinAmount(o.FilledAmount),
+		Status:         orderStatus(o.Status),
+		Creator:        o.Creator,
+		Timestamp:      o.CreatedAt,
+	}
+}
+
+// orderStatus maps the order statuses of the chain to the ones of the feed
+func orderStatus(status predictiontypes.OrderStatus) string {
+	switch status {
+	case predictiontypes.ORDER_STATUS_OPEN:
+		return "PENDING"
+	case predictiontypes.ORDER_STATUS_PARTIALLY_FILLED:
+		return "PARTIAL"
+	default:
+		return strings.TrimPrefix(status.String(), "ORDER_STATUS_")
+	}
+}
+
+func coinAmount(coin *sdk.Coin) string {
+	if coin == nil || coin.Amount.IsNil() {
+		return "0"
+	}
+	return coin.Amount.String()
+}
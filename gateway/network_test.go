@@ -0,0 +1,78 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"speculod/client"
+	"speculod/gateway"
+	"speculod/testutil/network"
+	predictiontypes "speculod/x/prediction/types"
+)
+
+// TestGatewayNetwork feeds the gateway from the RPC event subscription of an
+// in-process chain
+func TestGatewayNetwork(t *testing.T) {
+	cfg := network.DefaultConfig()
+	net := network.New(t, cfg)
+	val := net.Validators[0]
+
+	rpc, err := rpchttp.New(val.RPCAddress, "/websocket")
+	require.NoError(t, err)
+	require.NoError(t, rpc.Start())
+	defer func() { _ = rpc.Stop() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	g := gateway.New(log.NewNopLogger())
+	runErr := make(chan error, 1)
+	go func() { runErr <- g.Run(ctx, rpc) }()
+	mux := http.NewServeMux()
+	mux.Handle("/websocket", g)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	ws := dial(t, srv, "address="+val.Address.String()+"&type=market.created,order.posted")
+
+	conn, err := grpc.NewClient(val.AppConfig.GRPC.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	c, err := client.New(ctx, conn, val.ClientCtx.Keyring,
+		client.WithGasPrices(cfg.MinGasPrices),
+		client.WithPollInterval(100*time.Millisecond))
+	require.NoError(t, err)
+
+	// Give the gateway a block to subscribe before the market is created
+	require.NoError(t, net.WaitForNextBlock())
+	marketId, err := c.CreateMarket(ctx, val.Moniker, predictiontypes.MsgCreateMarket{
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	res, err := c.PostOrder(ctx, val.Moniker, marketId, 1, "SELL", "0.55", sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, err)
+
+	messages := read(t, ws, 2)
+	require.Equal(t, []string{gateway.TypeMarketCreated, gateway.TypeOrderPosted}, types(messages))
+	var posted gateway.OrderPostedData
+	require.NoError(t, json.Unmarshal(messages[1].Data, &posted))
+	require.Equal(t, res.OrderId, posted.Order.Id)
+	require.Equal(t, marketId, posted.MarketId)
+	require.Equal(t, uint32(1), posted.OutcomeIndex)
+	require.Equal(t, "SELL", posted.Order.Side)
+	require.Equal(t, "PENDING", posted.Order.Status)
+
+	cancel()
+	require.NoError(t, <-runErr)
+}
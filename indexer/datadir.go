@@ -0,0 +1,62 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+)
+
+// SyncDataDir indexes the blocks stored in the data directory of the node
+// at home, from the block after the cursor up to the last stored block, and
+// returns the height of the last indexed block. The databases of a running
+// node are locked, so the node must be stopped, and it must keep its
+// FinalizeBlock responses (storage.discard_abci_responses = false).
+func (idx *Indexer) SyncDataDir(ctx context.Context, home, dbBackend string) (int64, error) {
+	cfg := cmtcfg.DefaultConfig()
+	cfg.SetRoot(home)
+	if dbBackend != "" {
+		cfg.DBBackend = dbBackend
+	}
+	blockDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return 0, fmt.Errorf("open block store: %w", err)
+	}
+	defer blockDB.Close()
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return 0, fmt.Errorf("open state store: %w", err)
+	}
+	defer stateDB.Close()
+	blockStore := store.NewBlockStore(blockDB)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+
+	height, err := idx.Height(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if next := height + 1; next < blockStore.Base() {
+		return height, fmt.Errorf("block %d was pruned, the node only stores blocks from %d", next, blockStore.Base())
+	}
+	for height < blockStore.Height() {
+		if err := ctx.Err(); err != nil {
+			return height, err
+		}
+		next := height + 1
+		meta := blockStore.LoadBlockMeta(next)
+		if meta == nil {
+			return height, fmt.Errorf("block %d not found", next)
+		}
+		res, err := stateStore.LoadFinalizeBlockResponse(next)
+		if err != nil {
+			return height, fmt.Errorf("load results of block %d: %w", next, err)
+		}
+		if err := idx.IndexBlock(ctx, Block{Height: next, Time: meta.Header.Time, Events: BlockEvents(res)}); err != nil {
+			return height, err
+		}
+		height = next
+	}
+	return height, nil
+}
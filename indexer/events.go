@@ -0,0 +1,257 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	predictiontypes "speculod/x/prediction/types"
+	reputationtypes "speculod/x/reputation/types"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+// CandleIntervals are the lengths in seconds of the candles built from trades
+var CandleIntervals = []int64{60, 3600, 86400}
+
+// Market statuses of the markets table. Finalized markets have their outcome
+// settled.
+const (
+	MarketStatusOpen      = "open"
+	MarketStatusClosed    = "closed"
+	MarketStatusFinalized = "finalized"
+)
+
+// Order statuses of the orders table
+const (
+	OrderStatusOpen            = "open"
+	OrderStatusPartiallyFilled = "partially_filled"
+	OrderStatusFilled          = "filled"
+	OrderStatusCancelled       = "cancelled"
+	OrderStatusExpired         = "expired"
+)
+
+// writer applies the events of one block within a database transaction
+type writer struct {
+	tx      *sql.Tx
+	dialect dialect
+	block   Block
+}
+
+func (w *writer) exec(ctx context.Context, query string, args ...any) error {
+	_, err := w.tx.ExecContext(ctx, w.dialect.rebind(query), args...)
+	return err
+}
+
+// apply writes the typed module event at index i of the block. Other events,
+// including module events unknown to this build, are ignored.
+func (w *writer) apply(ctx context.Context, i int, event abci.Event) error {
+	if !strings.HasPrefix(event.Type, "speculod.") || gogoproto.MessageType(event.Type) == nil {
+		return nil
+	}
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return err
+	}
+
+	now := w.block.Time.Unix()
+	switch e := msg.(type) {
+	case *predictiontypes.EventMarketCreated:
+		outcomes, err := json.Marshal(e.Outcomes)
+		if err != nil {
+			return err
+		}
+		return w.exec(ctx, `INSERT INTO markets (market_id, creator, question, outcomes, group_id, deadline, status, created_height, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			e.MarketId, e.Creator, e.Question, string(outcomes), e.GroupId, e.Deadline, MarketStatusOpen, w.block.Height, now)
+
+	case *predictiontypes.EventMarketClosed:
+		return w.exec(ctx, `UPDATE markets SET status = ?, closed_at = ? WHERE market_id = ? AND status = ?`,
+			MarketStatusClosed, e.ClosedAt, e.MarketId, MarketStatusOpen)
+
+	case *predictiontypes.EventOrderPosted:
+		return w.upsertOrder(ctx, e.Order)
+
+	case *predictiontypes.EventOrderCancelled:
+		return w.exec(ctx, `UPDATE orders SET status = ?, updated_height = ? WHERE order_id = ?`,
+			OrderStatusCancelled, w.block.Height, e.OrderId)
+
+	case *predictiontypes.EventOrderExpired:
+		return w.exec(ctx, `UPDATE orders SET status = ?, updated_height = ? WHERE order_id = ?`,
+			OrderStatusExpired, w.block.Height, e.OrderId)
+
+	case *predictiontypes.EventTrade:
+		return w.trade(ctx, e.Trade)
+
+	case *settlementtypes.EventVoteCommitted:
+		return w.exec(ctx, `INSERT INTO votes (market_id, voter, committed_height, committed_at) VALUES (?, ?, ?, ?)`,
+			e.MarketId, e.Voter, w.block.Height, now)
+
+	case *settlementtypes.EventVoteRevealed:
+		return w.exec(ctx, `UPDATE votes SET vote = ?, revealed_height = ?, revealed_at = ? WHERE market_id = ? AND voter = ?`,
+			e.Vote, w.block.Height, now, e.MarketId, e.Voter)
+
+	case *settlementtypes.EventOutcomeFinalized:
+		return w.exec(ctx, `UPDATE markets SET status = ?, outcome = ?, finalized_at = ? WHERE market_id = ?`,
+			MarketStatusFinalized, e.Outcome, now, e.MarketId)
+
+	case *reputationtypes.EventReputationAdjusted:
+		return w.exec(ctx, `INSERT INTO reputation_changes (height, event_index, address, group_id, adjustment, previous_score, new_score, time)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			w.block.Height, i, e.Address, e.GroupId, e.Adjustment, e.PreviousScore, e.NewScore, now)
+	}
+	return nil
+}
+
+// upsertOrder stores the full state of an order
+func (w *writer) upsertOrder(ctx context.Context, order predictiontypes.Order) error {
+	amount, filled := coinAmount(order.Amount), coinAmount(order.FilledAmount)
+	denom := ""
+	if order.Amount != nil {
+		denom = order.Amount.Denom
+	}
+	return w.exec(ctx, `INSERT INTO orders (order_id, market_id, outcome_index, creator, side, price, denom, amount, filled, status, created_height, created_at, updated_height)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (order_id) DO UPDATE SET price = excluded.price, amount = excluded.amount,
+			filled = excluded.filled, status = excluded.status, updated_height = excluded.updated_height`,
+		order.Id, order.MarketId, order.OutcomeIndex, order.Creator, orderSide(order.Side), order.Price, denom,
+		amount, filled, orderStatus(order.Status), w.block.Height, order.CreatedAt, w.block.Height)
+}
+
+// trade records a trade, adds it to the fills of the matched orders and to
+// the candles of its outcome
+func (w *writer) trade(ctx context.Context, trade predictiontypes.Trade) error {
+	amount, denom := coinAmount(trade.Amount), ""
+	if trade.Amount != nil {
+		denom = trade.Amount.Denom
+	}
+	at := trade.Timestamp
+	if at == 0 {
+		at = w.block.Time.Unix()
+	}
+	if err := w.exec(ctx, `INSERT INTO trades (trade_id, market_id, outcome_index, buyer, seller, price, denom, amount, buy_order_id, sell_order_id, height, time)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		trade.TradeId, trade.MarketId, trade.OutcomeIndex, trade.Buyer, trade.Seller, trade.Price, denom, amount,
+		trade.BuyOrderId, trade.SellOrderId, w.block.Height, at); err != nil {
+		return err
+	}
+
+	// A direct fill only has the resting order on one side, the owner and
+	// side checks keep the filler's zero id from matching order 0
+	for _, side := range []struct {
+		orderId uint64
+		owner   string
+		side    predictiontypes.OrderSide
+	}{
+		{trade.BuyOrderId, trade.Buyer, predictiontypes.ORDER_SIDE_BUY},
+		{trade.SellOrderId, trade.Seller, predictiontypes.ORDER_SIDE_SELL},
+	} {
+		if err := w.fill(ctx, side.orderId, side.owner, side.side, trade.Amount); err != nil {
+			return err
+		}
+	}
+
+	price, err := math.LegacyNewDecFromStr(trade.Price)
+	if err != nil {
+		return err
+	}
+	for _, interval := range CandleIntervals {
+		if err := w.candle(ctx, trade, interval, at-at%interval, price); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fill adds amount to the filled amount of an open order
+func (w *writer) fill(ctx context.Context, orderId uint64, owner string, side predictiontypes.OrderSide, amount *sdk.Coin) error {
+	var orderAmount, filled string
+	err := w.tx.QueryRowContext(ctx, w.dialect.rebind(`SELECT amount, filled FROM orders
+		WHERE order_id = ? AND creator = ? AND side = ? AND status IN (?, ?)`),
+		orderId, owner, orderSide(side), OrderStatusOpen, OrderStatusPartiallyFilled).Scan(&orderAmount, &filled)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	total, err := addAmount(filled, amount)
+	if err != nil {
+		return err
+	}
+	size, ok := math.NewIntFromString(orderAmount)
+	if !ok {
+		return fmt.Errorf("invalid amount %q of order %d", orderAmount, orderId)
+	}
+	status := OrderStatusPartiallyFilled
+	if total.GTE(size) {
+		status = OrderStatusFilled
+	}
+	return w.exec(ctx, `UPDATE orders SET filled = ?, status = ?, updated_height = ? WHERE order_id = ?`,
+		total.String(), status, w.block.Height, orderId)
+}
+
+// candle adds a trade to the candle of the given interval starting at start
+func (w *writer) candle(ctx context.Context, trade predictiontypes.Trade, interval, start int64, price math.LegacyDec) error {
+	var high, low, volume string
+	err := w.tx.QueryRowContext(ctx, w.dialect.rebind(`SELECT high, low, volume FROM candles
+		WHERE market_id = ? AND outcome_index = ? AND interval_seconds = ? AND start_time = ?`),
+		trade.MarketId, trade.OutcomeIndex, interval, start).Scan(&high, &low, &volume)
+	if errors.Is(err, sql.ErrNoRows) {
+		return w.exec(ctx, `INSERT INTO candles (market_id, outcome_index, interval_seconds, start_time, open, high, low, close, volume, trades)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, 1)`,
+			trade.MarketId, trade.OutcomeIndex, interval, start, trade.Price, trade.Price, trade.Price, trade.Price, coinAmount(trade.Amount))
+	} else if err != nil {
+		return err
+	}
+	total, err := addAmount(volume, trade.Amount)
+	if err != nil {
+		return err
+	}
+
+	if h, err := math.LegacyNewDecFromStr(high); err != nil || price.GT(h) {
+		high = trade.Price
+	}
+	if l, err := math.LegacyNewDecFromStr(low); err != nil || price.LT(l) {
+		low = trade.Price
+	}
+	return w.exec(ctx, `UPDATE candles SET high = ?, low = ?, close = ?, volume = ?, trades = trades + 1
+		WHERE market_id = ? AND outcome_index = ? AND interval_seconds = ? AND start_time = ?`,
+		high, low, trade.Price, total.String(), trade.MarketId, trade.OutcomeIndex, interval, start)
+}
+
+// addAmount adds coin to the stored amount
+func addAmount(stored string, coin *sdk.Coin) (math.Int, error) {
+	total, ok := math.NewIntFromString(stored)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid stored amount %q", stored)
+	}
+	if coin == nil || coin.Amount.IsNil() {
+		return total, nil
+	}
+	return total.Add(coin.Amount), nil
+}
+
+func coinAmount(coin *sdk.Coin) string {
+	if coin == nil || coin.Amount.IsNil() {
+		return "0"
+	}
+	return coin.Amount.String()
+}
+
+// orderSide maps ORDER_SIDE_BUY to buy
+func orderSide(side predictiontypes.OrderSide) string {
+	return strings.ToLower(strings.TrimPrefix(side.String(), "ORDER_SIDE_"))
+}
+
+// orderStatus maps ORDER_STATUS_PARTIALLY_FILLED to partially_filled
+func orderStatus(status predictiontypes.OrderStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "ORDER_STATUS_"))
+}
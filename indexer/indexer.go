@@ -0,0 +1,117 @@
+// Package indexer materializes the events of the custom modules into a SQL
+// database (SQLite or Postgres) and serves history queries over it. Blocks
+// are fed either live by the ABCI listener of the node, which catches up from
+// the data directory when the node starts, or from the data directory of a
+// stopped node. The height of the last indexed block is stored
+// with the data, so indexing resumes where it stopped.
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/lib/pq"  // postgres driver
+	_ "modernc.org/sqlite" // sqlite driver
+)
+
+// FlagDSN is the app.toml option that enables the indexer listener of the node
+const FlagDSN = "indexer.dsn"
+
+// Block is the input of the indexer: the events of one committed block, in
+// execution order
+type Block struct {
+	Height int64
+	Time   time.Time
+	Events []abci.Event
+}
+
+// errNotNext is returned for a block that does not follow the cursor
+var errNotNext = errors.New("does not follow the last indexed block")
+
+// Indexer writes blocks into a SQL database
+type Indexer struct {
+	db      *sql.DB
+	dialect dialect
+}
+
+// Open connects to the database identified by dsn and creates the schema.
+// Postgres databases are given as postgres:// URLs, anything else is the path
+// of a SQLite database, optionally prefixed with sqlite://.
+func Open(dsn string) (*Indexer, error) {
+	d := dialectSQLite
+	driver, source := "sqlite", strings.TrimPrefix(dsn, "sqlite://")
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		d = dialectPostgres
+		driver, source = "postgres", dsn
+	}
+	db, err := sql.Open(driver, source)
+	if err != nil {
+		return nil, err
+	}
+	if d == dialectSQLite {
+		// A single connection serializes the writes and keeps in-memory
+		// databases alive
+		db.SetMaxOpenConns(1)
+	}
+	idx := &Indexer{db: db, dialect: d}
+	if err := idx.migrate(context.Background()); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("create schema: %w", err)
+	}
+	return idx, nil
+}
+
+// Close closes the database
+func (idx *Indexer) Close() error {
+	return idx.db.Close()
+}
+
+// Height returns the height of the last indexed block, zero if none
+func (idx *Indexer) Height(ctx context.Context) (int64, error) {
+	var height int64
+	err := idx.db.QueryRowContext(ctx, "SELECT height FROM indexer_cursor WHERE id = 1").Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// IndexBlock writes the events of block and advances the cursor in one
+// database transaction. Blocks at or below the cursor were already indexed
+// and are skipped, so replaying blocks after a restart is harmless. Once a
+// block is indexed, the following blocks must be indexed in order.
+func (idx *Indexer) IndexBlock(ctx context.Context, block Block) error {
+	height, err := idx.Height(ctx)
+	if err != nil {
+		return err
+	}
+	if block.Height <= height {
+		return nil
+	}
+	if height > 0 && block.Height != height+1 {
+		return fmt.Errorf("block %d %w %d", block.Height, errNotNext, height)
+	}
+
+	tx, err := idx.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	w := &writer{tx: tx, dialect: idx.dialect, block: block}
+	for i, event := range block.Events {
+		if err := w.apply(ctx, i, event); err != nil {
+			return fmt.Errorf("block %d: event %d (%s): %w", block.Height, i, event.Type, err)
+		}
+	}
+	if err := w.exec(ctx, `INSERT INTO indexer_cursor (id, height) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET height = excluded.height`, block.Height); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
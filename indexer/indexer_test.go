@@ -0,0 +1,238 @@
+package indexer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"speculod/indexer"
+	predictiontypes "speculod/x/prediction/types"
+	reputationtypes "speculod/x/reputation/types"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+func events(t *testing.T, msgs ...proto.Message) []abci.Event {
+	t.Helper()
+	var events []abci.Event
+	for _, msg := range msgs {
+		event, err := sdk.TypedEventToEvent(msg)
+		require.NoError(t, err)
+		events = append(events, abci.Event(event))
+	}
+	return events
+}
+
+func order(id uint64, creator string, side predictiontypes.OrderSide, price string, amount int64) predictiontypes.Order {
+	coin, filled := sdk.NewInt64Coin("stake", amount), sdk.NewInt64Coin("stake", 0)
+	return predictiontypes.Order{
+		Id:           id,
+		MarketId:     1,
+		Creator:      creator,
+		Side:         side,
+		Price:        price,
+		Amount:       &coin,
+		FilledAmount: &filled,
+		Status:       predictiontypes.ORDER_STATUS_OPEN,
+		CreatedAt:    1_000,
+	}
+}
+
+func trade(id uint64, price string, amount, at int64) predictiontypes.Trade {
+	coin := sdk.NewInt64Coin("stake", amount)
+	return predictiontypes.Trade{
+		TradeId:     id,
+		MarketId:    1,
+		Buyer:       "alice",
+		Seller:      "bob",
+		Price:       price,
+		Amount:      &coin,
+		Timestamp:   at,
+		BuyOrderId:  0,
+		SellOrderId: 1,
+	}
+}
+
+// get decodes the JSON response of the handler for path into out
+func get(t *testing.T, h http.Handler, path string, out any) int {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	if out != nil && rec.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), out))
+	}
+	return rec.Code
+}
+
+func TestIndexBlocks(t *testing.T) {
+	ctx := context.Background()
+	idx, err := indexer.Open(filepath.Join(t.TempDir(), "index.db"))
+	require.NoError(t, err)
+	defer idx.Close()
+
+	blocks := []indexer.Block{
+		{Height: 1, Time: time.Unix(1_000, 0), Events: events(t,
+			&predictiontypes.EventMarketCreated{MarketId: 1, Creator: "carol", Question: "Rain?", Outcomes: []string{"Yes", "No"}, Deadline: 5_000},
+			&predictiontypes.EventOrderPosted{Order: order(0, "alice", predictiontypes.ORDER_SIDE_BUY, "0.6", 100)},
+			&predictiontypes.EventOrderPosted{Order: order(1, "bob", predictiontypes.ORDER_SIDE_SELL, "0.5", 40)},
+			&predictiontypes.EventTrade{Trade: trade(0, "0.6", 40, 1_010)},
+		)},
+		{Height: 2, Time: time.Unix(1_030, 0), Events: events(t,
+			&predictiontypes.EventTrade{Trade: trade(1, "0.4", 10, 1_030)},
+			&predictiontypes.EventTrade{Trade: trade(2, "0.7", 10, 1_070)},
+			&predictiontypes.EventOrderCancelled{OrderId: 0, MarketId: 1, Creator: "alice"},
+		)},
+		{Height: 3, Time: time.Unix(6_000, 0), Events: events(t,
+			&predictiontypes.EventMarketClosed{MarketId: 1, ClosedAt: 6_000},
+			&settlementtypes.EventVoteCommitted{MarketId: 1, Voter: "alice"},
+			&settlementtypes.EventVoteCommitted{MarketId: 1, Voter: "bob"},
+		)},
+		{Height: 4, Time: time.Unix(6_100, 0), Events: events(t,
+			&settlementtypes.EventVoteRevealed{MarketId: 1, Voter: "alice", Vote: "Yes"},
+			&settlementtypes.EventOutcomeFinalized{MarketId: 1, Outcome: "Yes", TotalVotes: 1},
+			&reputationtypes.EventReputationAdjusted{Address: "alice", Adjustment: 1, PreviousScore: "0", NewScore: "1"},
+		)},
+	}
+	for _, block := range blocks {
+		require.NoError(t, idx.IndexBlock(ctx, block))
+	}
+	// Replayed blocks are skipped, missing blocks are refused
+	require.NoError(t, idx.IndexBlock(ctx, blocks[1]))
+	require.ErrorContains(t, idx.IndexBlock(ctx, indexer.Block{Height: 6}), "does not follow")
+	height, err := idx.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), height)
+
+	h := idx.Handler()
+
+	var market indexer.Market
+	require.Equal(t, http.StatusOK, get(t, h, "/markets/1", &market))
+	require.Equal(t, []string{"Yes", "No"}, market.Outcomes)
+	require.Equal(t, indexer.MarketStatusFinalized, market.Status)
+	require.Equal(t, "Yes", market.Outcome)
+	require.Equal(t, int64(6_000), *market.ClosedAt)
+	require.Equal(t, http.StatusNotFound, get(t, h, "/markets/9", nil))
+
+	var orders []indexer.Order
+	require.Equal(t, http.StatusOK, get(t, h, "/users/bob/orders", &orders))
+	require.Len(t, orders, 1)
+	require.Equal(t, "40", orders[0].Filled)
+	require.Equal(t, indexer.OrderStatusFilled, orders[0].Status)
+	require.Equal(t, http.StatusOK, get(t, h, "/users/alice/orders", &orders))
+	require.Equal(t, "60", orders[0].Filled)
+	require.Equal(t, indexer.OrderStatusCancelled, orders[0].Status)
+
+	var trades []indexer.Trade
+	require.Equal(t, http.StatusOK, get(t, h, "/users/alice/trades?from=1020&to=1970-01-01T00:17:40Z", &trades))
+	require.Len(t, trades, 1)
+	require.Equal(t, uint64(1), trades[0].TradeId)
+	require.Equal(t, http.StatusOK, get(t, h, "/users/bob/trades?limit=2&offset=1", &trades))
+	require.Len(t, trades, 2)
+	require.Equal(t, http.StatusBadRequest, get(t, h, "/users/bob/trades?from=yesterday", nil))
+
+	var candles []indexer.Candle
+	require.Equal(t, http.StatusOK, get(t, h, "/markets/1/outcomes/0/candles?interval=60", &candles))
+	require.Equal(t, []indexer.Candle{
+		{StartTime: 960, Open: "0.6", High: "0.6", Low: "0.6", Close: "0.6", Volume: "40", Trades: 1},
+		{StartTime: 1_020, Open: "0.4", High: "0.7", Low: "0.4", Close: "0.7", Volume: "20", Trades: 2},
+	}, candles)
+
+	var votes []indexer.Vote
+	require.Equal(t, http.StatusOK, get(t, h, "/markets/1/votes", &votes))
+	require.Len(t, votes, 2)
+	require.Equal(t, "Yes", votes[0].Vote)
+	require.Equal(t, int64(4), *votes[0].RevealedHeight)
+	require.Nil(t, votes[1].RevealedHeight)
+
+	var changes []indexer.ReputationChange
+	require.Equal(t, http.StatusOK, get(t, h, "/users/alice/reputation", &changes))
+	require.Equal(t, []indexer.ReputationChange{
+		{Height: 4, Address: "alice", Adjustment: 1, PreviousScore: "0", NewScore: "1", Time: 6_100},
+	}, changes)
+
+	var status map[string]int64
+	require.Equal(t, http.StatusOK, get(t, h, "/status", &status))
+	require.Equal(t, int64(4), status["height"])
+}
+
+func TestListener(t *testing.T) {
+	ctx := context.Background()
+	dsn := filepath.Join(t.TempDir(), "index.db")
+	idx, err := indexer.Open(dsn)
+	require.NoError(t, err)
+	l := indexer.NewListener(idx, log.NewNopLogger())
+
+	// Amounts beyond 64 bits are kept exactly
+	big, ok := math.NewIntFromString("100000000000000000000000")
+	require.True(t, ok)
+	sell := order(0, "bob", predictiontypes.ORDER_SIDE_SELL, "0.5", 0)
+	sell.Amount.Amount = big.MulRaw(2)
+	fill := trade(0, "0.5", 0, 1_000)
+	fill.Amount.Amount = big
+	fill.SellOrderId = 0
+
+	commit := func(block indexer.Block) {
+		t.Helper()
+		res := abci.ResponseFinalizeBlock{Events: block.Events}
+		require.NoError(t, l.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: block.Height, Time: block.Time}, res))
+		require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	}
+	commit(indexer.Block{Height: 1, Time: time.Unix(1_000, 0), Events: events(t,
+		&predictiontypes.EventOrderPosted{Order: sell},
+		&predictiontypes.EventTrade{Trade: fill},
+	)})
+	fill.TradeId = 1
+	commit(indexer.Block{Height: 2, Time: time.Unix(1_010, 0), Events: events(t,
+		&predictiontypes.EventTrade{Trade: fill},
+	)})
+	// A missing block stops the listener without failing the commit
+	commit(indexer.Block{Height: 4, Time: time.Unix(1_030, 0)})
+	commit(indexer.Block{Height: 5, Time: time.Unix(1_040, 0)})
+	require.NoError(t, l.Close())
+
+	idx, err = indexer.Open(dsn)
+	require.NoError(t, err)
+	defer idx.Close()
+	height, err := idx.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+
+	h := idx.Handler()
+	var orders []indexer.Order
+	require.Equal(t, http.StatusOK, get(t, h, "/users/bob/orders", &orders))
+	require.Len(t, orders, 1)
+	require.Equal(t, big.MulRaw(2).String(), orders[0].Filled)
+	require.Equal(t, indexer.OrderStatusFilled, orders[0].Status)
+	var candles []indexer.Candle
+	require.Equal(t, http.StatusOK, get(t, h, "/markets/1/outcomes/0/candles?interval=60", &candles))
+	require.Len(t, candles, 1)
+	require.Equal(t, big.MulRaw(2).String(), candles[0].Volume)
+}
+
+func TestBlockEvents(t *testing.T) {
+	mode := func(typ, mode string) abci.Event {
+		return abci.Event{Type: typ, Attributes: []abci.EventAttribute{{Key: "mode", Value: mode}}}
+	}
+	res := &abci.ResponseFinalizeBlock{
+		Events: []abci.Event{mode("begin", "BeginBlock"), mode("end", "EndBlock")},
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{{Type: "ok"}}},
+			{Code: 5, Events: []abci.Event{{Type: "failed"}}},
+		},
+	}
+	var types []string
+	for _, event := range indexer.BlockEvents(res) {
+		types = append(types, event.Type)
+	}
+	require.Equal(t, []string{"begin", "ok", "end"}, types)
+}
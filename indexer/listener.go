@@ -0,0 +1,151 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+const (
+	// listenerQueueSize is the number of committed blocks waiting to be
+	// written before the listener drops blocks
+	listenerQueueSize = 1000
+	// retryInterval is the wait before retrying a failed write, doubled after
+	// every failure up to maxRetryInterval
+	retryInterval    = time.Second
+	maxRetryInterval = time.Minute
+)
+
+// Listener indexes the blocks committed by the node it is registered with.
+// The events of a block are kept from FinalizeBlock and queued on Commit, so
+// blocks that never commit are not indexed. A worker writes the queued blocks
+// in order, retrying failed writes, so the database never holds up
+// consensus.
+//
+// When the queue is full the block is dropped and the listener stops at the
+// gap. The node indexes the missing blocks from its data directory when it
+// restarts, see SyncDataDir.
+type Listener struct {
+	idx     *Indexer
+	logger  log.Logger
+	pending *Block
+
+	queue   chan Block
+	closing chan struct{}
+	done    chan struct{}
+}
+
+// NewListener returns a listener writing into idx and starts its worker
+func NewListener(idx *Indexer, logger log.Logger) *Listener {
+	l := &Listener{
+		idx:     idx,
+		logger:  logger,
+		queue:   make(chan Block, listenerQueueSize),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go l.run()
+	return l
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.pending = &Block{Height: req.Height, Time: req.Time, Events: BlockEvents(&res)}
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener
+func (l *Listener) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	if l.pending == nil {
+		return nil
+	}
+	block := *l.pending
+	l.pending = nil
+	select {
+	case l.queue <- block:
+	default:
+		l.logger.Error("indexer queue is full, dropping block", "height", block.Height)
+	}
+	return nil
+}
+
+// Close writes the queued blocks, stops the worker and closes the indexer. A
+// block that fails to write is not retried any more.
+func (l *Listener) Close() error {
+	close(l.closing)
+	close(l.queue)
+	<-l.done
+	return l.idx.Close()
+}
+
+// run writes the queued blocks until the queue is closed
+func (l *Listener) run() {
+	defer close(l.done)
+	stopped := false
+	for block := range l.queue {
+		if stopped {
+			continue
+		}
+		if err := l.write(block); err != nil {
+			l.logger.Error("indexer stopped, restart the node to index the missing blocks from its data directory",
+				"height", block.Height, "err", err)
+			stopped = true
+		}
+	}
+}
+
+// write indexes block, retrying until it succeeds, the block does not follow
+// the cursor or the listener is closed
+func (l *Listener) write(block Block) error {
+	interval := retryInterval
+	for {
+		err := l.idx.IndexBlock(context.Background(), block)
+		if err == nil || errors.Is(err, errNotNext) {
+			return err
+		}
+		l.logger.Error("failed to index block, retrying", "height", block.Height, "in", interval, "err", err)
+		select {
+		case <-time.After(interval):
+		case <-l.closing:
+			return err
+		}
+		interval = min(2*interval, maxRetryInterval)
+	}
+}
+
+// BlockEvents returns the events of a block in execution order: the begin
+// block events, the events of the successful transactions and the end block
+// events. The events of failed transactions were reverted and are left out.
+func BlockEvents(res *abci.ResponseFinalizeBlock) []abci.Event {
+	var begin, end []abci.Event
+	for _, event := range res.Events {
+		if eventMode(event) == "EndBlock" {
+			end = append(end, event)
+		} else {
+			begin = append(begin, event)
+		}
+	}
+	events := begin
+	for _, tx := range res.TxResults {
+		if tx.Code == abci.CodeTypeOK {
+			events = append(events, tx.Events...)
+		}
+	}
+	return append(events, end...)
+}
+
+// eventMode returns the mode attribute the SDK sets on block events
+func eventMode(event abci.Event) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == "mode" {
+			return attr.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+package indexer_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdknetwork "github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"speculod/app"
+	"speculod/client"
+	"speculod/indexer"
+	"speculod/testutil/network"
+	predictiontypes "speculod/x/prediction/types"
+)
+
+// TestIndexNetwork indexes an in-process chain live through the streaming
+// listener, then again from the data directory of the stopped node, and
+// checks both databases serve the same history.
+func TestIndexNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in unit-tests mode.")
+	}
+	liveDSN := filepath.Join(t.TempDir(), "live.db")
+	cfg := network.DefaultConfig()
+	cfg.CleanupDir = false
+	cfg.AppConstructor = func(val sdknetwork.ValidatorI) servertypes.Application {
+		return app.New(
+			val.GetCtx().Logger,
+			dbm.NewMemDB(),
+			nil,
+			true,
+			simtestutil.AppOptionsMap{indexer.FlagDSN: liveDSN},
+			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(cfg.ChainID),
+		)
+	}
+	// The node must be stopped before its data directory can be read, so the
+	// network is cleaned up by the test rather than by network.New
+	net, err := sdknetwork.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	stopped := false
+	defer func() {
+		if !stopped {
+			net.Cleanup()
+		}
+	}()
+	_, err = net.WaitForHeight(1)
+	require.NoError(t, err)
+
+	val := net.Validators[0]
+	conn, err := grpc.NewClient(val.AppConfig.GRPC.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	c, err := client.New(ctx, conn, val.ClientCtx.Keyring,
+		client.WithGasPrices(cfg.MinGasPrices),
+		client.WithPollInterval(100*time.Millisecond))
+	require.NoError(t, err)
+
+	marketId, err := c.CreateMarket(ctx, val.Moniker, predictiontypes.MsgCreateMarket{
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	var orderIds []uint64
+	for _, price := range []string{"0.3", "0.4"} {
+		res, err := c.PostOrder(ctx, val.Moniker, marketId, 0, "BUY", price, sdk.NewInt64Coin("stake", 10))
+		require.NoError(t, err)
+		orderIds = append(orderIds, res.OrderId)
+	}
+	require.NoError(t, c.CancelOrder(ctx, val.Moniker, orderIds[0]))
+	require.NoError(t, net.WaitForNextBlock())
+
+	net.Cleanup()
+	stopped = true
+
+	live, err := indexer.Open(liveDSN)
+	require.NoError(t, err)
+	defer live.Close()
+	synced, err := indexer.Open(filepath.Join(t.TempDir(), "synced.db"))
+	require.NoError(t, err)
+	defer synced.Close()
+	height, err := synced.SyncDataDir(ctx, val.GetCtx().Config.RootDir, string(val.GetCtx().Config.DBBackend))
+	require.NoError(t, err)
+	liveHeight, err := live.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, liveHeight, height)
+
+	owner := val.Address.String()
+	var liveOrders, syncedOrders []indexer.Order
+	require.Equal(t, http.StatusOK, get(t, live.Handler(), "/users/"+owner+"/orders", &liveOrders))
+	require.Equal(t, http.StatusOK, get(t, synced.Handler(), "/users/"+owner+"/orders", &syncedOrders))
+	require.Len(t, liveOrders, 2)
+	require.Equal(t, indexer.OrderStatusCancelled, liveOrders[0].Status)
+	require.Equal(t, indexer.OrderStatusOpen, liveOrders[1].Status)
+	require.Equal(t, liveOrders, syncedOrders)
+
+	var liveMarkets, syncedMarkets []indexer.Market
+	require.Equal(t, http.StatusOK, get(t, live.Handler(), "/markets", &liveMarkets))
+	require.Equal(t, http.StatusOK, get(t, synced.Handler(), "/markets", &syncedMarkets))
+	require.Len(t, liveMarkets, 1)
+	require.Equal(t, owner, liveMarkets[0].Creator)
+	require.Equal(t, liveMarkets, syncedMarkets)
+
+	// Syncing again resumes from the cursor
+	height, err = synced.SyncDataDir(ctx, val.GetCtx().Config.RootDir, string(val.GetCtx().Config.DBBackend))
+	require.NoError(t, err)
+	require.Equal(t, liveHeight, height)
+}
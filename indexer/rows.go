@@ -0,0 +1,105 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// scanner is implemented by *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+const (
+	marketColumns     = "market_id, creator, question, outcomes, group_id, deadline, status, outcome, created_height, created_at, closed_at, finalized_at"
+	orderColumns      = "order_id, market_id, outcome_index, creator, side, price, denom, amount, filled, status, created_height, created_at, updated_height"
+	tradeColumns      = "trade_id, market_id, outcome_index, buyer, seller, price, denom, amount, buy_order_id, sell_order_id, height, time"
+	candleColumns     = "start_time, open, high, low, close, volume, trades"
+	voteColumns       = "market_id, voter, committed_height, committed_at, vote, revealed_height, revealed_at"
+	reputationColumns = "height, address, group_id, adjustment, previous_score, new_score, time"
+)
+
+func scanMarket(s scanner) (Market, error) {
+	var (
+		m                     Market
+		outcomes              string
+		closedAt, finalizedAt sql.NullInt64
+	)
+	if err := s.Scan(&m.MarketId, &m.Creator, &m.Question, &outcomes, &m.GroupId, &m.Deadline, &m.Status, &m.Outcome,
+		&m.CreatedHeight, &m.CreatedAt, &closedAt, &finalizedAt); err != nil {
+		return m, err
+	}
+	m.ClosedAt, m.FinalizedAt = nullInt(closedAt), nullInt(finalizedAt)
+	return m, json.Unmarshal([]byte(outcomes), &m.Outcomes)
+}
+
+func scanOrder(s scanner) (Order, error) {
+	var o Order
+	err := s.Scan(&o.OrderId, &o.MarketId, &o.OutcomeIndex, &o.Creator, &o.Side, &o.Price, &o.Denom, &o.Amount, &o.Filled,
+		&o.Status, &o.CreatedHeight, &o.CreatedAt, &o.UpdatedHeight)
+	return o, err
+}
+
+func scanTrade(s scanner) (Trade, error) {
+	var t Trade
+	err := s.Scan(&t.TradeId, &t.MarketId, &t.OutcomeIndex, &t.Buyer, &t.Seller, &t.Price, &t.Denom, &t.Amount,
+		&t.BuyOrderId, &t.SellOrderId, &t.Height, &t.Time)
+	return t, err
+}
+
+func scanCandle(s scanner) (Candle, error) {
+	var c Candle
+	err := s.Scan(&c.StartTime, &c.Open, &c.High, &c.Low, &c.Close, &c.Volume, &c.Trades)
+	return c, err
+}
+
+func scanVote(s scanner) (Vote, error) {
+	var (
+		v                          Vote
+		revealedHeight, revealedAt sql.NullInt64
+	)
+	if err := s.Scan(&v.MarketId, &v.Voter, &v.CommittedHeight, &v.CommittedAt, &v.Vote, &revealedHeight, &revealedAt); err != nil {
+		return v, err
+	}
+	v.RevealedHeight, v.RevealedAt = nullInt(revealedHeight), nullInt(revealedAt)
+	return v, nil
+}
+
+func scanReputationChange(s scanner) (ReputationChange, error) {
+	var c ReputationChange
+	err := s.Scan(&c.Height, &c.Address, &c.GroupId, &c.Adjustment, &c.PreviousScore, &c.NewScore, &c.Time)
+	return c, err
+}
+
+func nullInt(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+// parseTime accepts unix seconds or an RFC 3339 timestamp
+func parseTime(v string) (int64, error) {
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return secs, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return 0, err
+	}
+	return t.Unix(), nil
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,150 @@
+package indexer
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// dialect is the SQL flavor of the database
+type dialect int
+
+const (
+	dialectSQLite dialect = iota
+	dialectPostgres
+)
+
+// rebind rewrites the ? placeholders of query for the dialect
+func (d dialect) rebind(query string) string {
+	if d != dialectPostgres {
+		return query
+	}
+	var (
+		b strings.Builder
+		n int
+	)
+	for _, r := range query {
+		if r != '?' {
+			b.WriteRune(r)
+			continue
+		}
+		n++
+		b.WriteString("$" + strconv.Itoa(n))
+	}
+	return b.String()
+}
+
+// amountType is the column type of token amounts. SQLite would round NUMERIC
+// amounts above 2^63 to floats, so it keeps them as the decimal strings of
+// the chain; the indexer adds them up in Go for both dialects.
+func (d dialect) amountType() string {
+	if d == dialectPostgres {
+		return "NUMERIC"
+	}
+	return "TEXT"
+}
+
+// schema only uses types and statements understood by both SQLite and
+// Postgres, AMOUNT being replaced by the amount type of the dialect. Prices
+// are kept as the decimal strings of the chain.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS indexer_cursor (
+		id INTEGER PRIMARY KEY,
+		height BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS markets (
+		market_id BIGINT PRIMARY KEY,
+		creator TEXT NOT NULL,
+		question TEXT NOT NULL,
+		outcomes TEXT NOT NULL,
+		group_id TEXT NOT NULL,
+		deadline BIGINT NOT NULL,
+		status TEXT NOT NULL,
+		outcome TEXT NOT NULL DEFAULT '',
+		created_height BIGINT NOT NULL,
+		created_at BIGINT NOT NULL,
+		closed_at BIGINT,
+		finalized_at BIGINT
+	)`,
+	`CREATE TABLE IF NOT EXISTS orders (
+		order_id BIGINT PRIMARY KEY,
+		market_id BIGINT NOT NULL,
+		outcome_index INTEGER NOT NULL,
+		creator TEXT NOT NULL,
+		side TEXT NOT NULL,
+		price TEXT NOT NULL,
+		denom TEXT NOT NULL,
+		amount AMOUNT NOT NULL,
+		filled AMOUNT NOT NULL,
+		status TEXT NOT NULL,
+		created_height BIGINT NOT NULL,
+		created_at BIGINT NOT NULL,
+		updated_height BIGINT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS orders_by_creator ON orders (creator, created_at)`,
+	`CREATE INDEX IF NOT EXISTS orders_by_market ON orders (market_id, outcome_index, status)`,
+	`CREATE TABLE IF NOT EXISTS trades (
+		trade_id BIGINT PRIMARY KEY,
+		market_id BIGINT NOT NULL,
+		outcome_index INTEGER NOT NULL,
+		buyer TEXT NOT NULL,
+		seller TEXT NOT NULL,
+		price TEXT NOT NULL,
+		denom TEXT NOT NULL,
+		amount AMOUNT NOT NULL,
+		buy_order_id BIGINT NOT NULL,
+		sell_order_id BIGINT NOT NULL,
+		height BIGINT NOT NULL,
+		time BIGINT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS trades_by_buyer ON trades (buyer, time)`,
+	`CREATE INDEX IF NOT EXISTS trades_by_seller ON trades (seller, time)`,
+	`CREATE INDEX IF NOT EXISTS trades_by_market ON trades (market_id, outcome_index, time)`,
+	`CREATE TABLE IF NOT EXISTS candles (
+		market_id BIGINT NOT NULL,
+		outcome_index INTEGER NOT NULL,
+		interval_seconds BIGINT NOT NULL,
+		start_time BIGINT NOT NULL,
+		open TEXT NOT NULL,
+		high TEXT NOT NULL,
+		low TEXT NOT NULL,
+		close TEXT NOT NULL,
+		volume AMOUNT NOT NULL,
+		trades BIGINT NOT NULL,
+		PRIMARY KEY (market_id, outcome_index, interval_seconds, start_time)
+	)`,
+	`CREATE TABLE IF NOT EXISTS votes (
+		market_id BIGINT NOT NULL,
+		voter TEXT NOT NULL,
+		committed_height BIGINT NOT NULL,
+		committed_at BIGINT NOT NULL,
+		vote TEXT NOT NULL DEFAULT '',
+		revealed_height BIGINT,
+		revealed_at BIGINT,
+		PRIMARY KEY (market_id, voter)
+	)`,
+	`CREATE INDEX IF NOT EXISTS votes_by_voter ON votes (voter, committed_at)`,
+	`CREATE TABLE IF NOT EXISTS reputation_changes (
+		height BIGINT NOT NULL,
+		event_index INTEGER NOT NULL,
+		address TEXT NOT NULL,
+		group_id TEXT NOT NULL,
+		adjustment BIGINT NOT NULL,
+		previous_score TEXT NOT NULL,
+		new_score TEXT NOT NULL,
+		time BIGINT NOT NULL,
+		PRIMARY KEY (height, event_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS reputation_changes_by_address ON reputation_changes (address, time)`,
+}
+
+// migrate creates the tables and indexes that do not exist yet
+func (idx *Indexer) migrate(ctx context.Context) error {
+	for _, stmt := range schema {
+		stmt = strings.ReplaceAll(stmt, "AMOUNT", idx.dialect.amountType())
+		if _, err := idx.db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,308 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// Market is a row of the markets table
+type Market struct {
+	MarketId      uint64   `json:"market_id"`
+	Creator       string   `json:"creator"`
+	Question      string   `json:"question"`
+	Outcomes      []string `json:"outcomes"`
+	GroupId       string   `json:"group_id"`
+	Deadline      int64    `json:"deadline"`
+	Status        string   `json:"status"`
+	Outcome       string   `json:"outcome,omitempty"`
+	CreatedHeight int64    `json:"created_height"`
+	CreatedAt     int64    `json:"created_at"`
+	ClosedAt      *int64   `json:"closed_at,omitempty"`
+	FinalizedAt   *int64   `json:"finalized_at,omitempty"`
+}
+
+// Order is a row of the orders table
+type Order struct {
+	OrderId       uint64 `json:"order_id"`
+	MarketId      uint64 `json:"market_id"`
+	OutcomeIndex  uint32 `json:"outcome_index"`
+	Creator       string `json:"creator"`
+	Side          string `json:"side"`
+	Price         string `json:"price"`
+	Denom         string `json:"denom"`
+	Amount        string `json:"amount"`
+	Filled        string `json:"filled"`
+	Status        string `json:"status"`
+	CreatedHeight int64  `json:"created_height"`
+	CreatedAt     int64  `json:"created_at"`
+	UpdatedHeight int64  `json:"updated_height"`
+}
+
+// Trade is a row of the trades table
+type Trade struct {
+	TradeId      uint64 `json:"trade_id"`
+	MarketId     uint64 `json:"market_id"`
+	OutcomeIndex uint32 `json:"outcome_index"`
+	Buyer        string `json:"buyer"`
+	Seller       string `json:"seller"`
+	Price        string `json:"price"`
+	Denom        string `json:"denom"`
+	Amount       string `json:"amount"`
+	BuyOrderId   uint64 `json:"buy_order_id"`
+	SellOrderId  uint64 `json:"sell_order_id"`
+	Height       int64  `json:"height"`
+	Time         int64  `json:"time"`
+}
+
+// Candle is a row of the candles table
+type Candle struct {
+	StartTime int64  `json:"start_time"`
+	Open      string `json:"open"`
+	High      string `json:"high"`
+	Low       string `json:"low"`
+	Close     string `json:"close"`
+	Volume    string `json:"volume"`
+	Trades    int64  `json:"trades"`
+}
+
+// Vote is a row of the votes table
+type Vote struct {
+	MarketId        uint64 `json:"market_id"`
+	Voter           string `json:"voter"`
+	CommittedHeight int64  `json:"committed_height"`
+	CommittedAt     int64  `json:"committed_at"`
+	Vote            string `json:"vote,omitempty"`
+	RevealedHeight  *int64 `json:"revealed_height,omitempty"`
+	RevealedAt      *int64 `json:"revealed_at,omitempty"`
+}
+
+// ReputationChange is a row of the reputation_changes table
+type ReputationChange struct {
+	Height        int64  `json:"height"`
+	Address       string `json:"address"`
+	GroupId       string `json:"group_id"`
+	Adjustment    int64  `json:"adjustment"`
+	PreviousScore string `json:"previous_score"`
+	NewScore      string `json:"new_score"`
+	Time          int64  `json:"time"`
+}
+
+// Handler returns the REST API serving the indexed history. List endpoints
+// take limit and offset parameters, time ranges are given by from and to as
+// unix seconds or RFC 3339 timestamps.
+func (idx *Indexer) Handler() http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc("/status", idx.handleStatus).Methods(http.MethodGet)
+	r.HandleFunc("/markets", idx.handleMarkets).Methods(http.MethodGet)
+	r.HandleFunc("/markets/{market_id:[0-9]+}", idx.handleMarket).Methods(http.MethodGet)
+	r.HandleFunc("/markets/{market_id:[0-9]+}/trades", idx.handleMarketTrades).Methods(http.MethodGet)
+	r.HandleFunc("/markets/{market_id:[0-9]+}/votes", idx.handleMarketVotes).Methods(http.MethodGet)
+	r.HandleFunc("/markets/{market_id:[0-9]+}/outcomes/{outcome_index:[0-9]+}/candles", idx.handleCandles).Methods(http.MethodGet)
+	r.HandleFunc("/users/{address}/orders", idx.handleUserOrders).Methods(http.MethodGet)
+	r.HandleFunc("/users/{address}/trades", idx.handleUserTrades).Methods(http.MethodGet)
+	r.HandleFunc("/users/{address}/votes", idx.handleUserVotes).Methods(http.MethodGet)
+	r.HandleFunc("/users/{address}/reputation", idx.handleUserReputation).Methods(http.MethodGet)
+	return r
+}
+
+func (idx *Indexer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	height, err := idx.Height(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, map[string]int64{"height": height})
+}
+
+func (idx *Indexer) handleMarkets(w http.ResponseWriter, r *http.Request) {
+	q := newQuery(r)
+	q.whereParam("status", "status")
+	q.whereParam("group_id", "group_id")
+	serveList(w, r, idx, q, "SELECT "+marketColumns+" FROM markets", "market_id", scanMarket)
+}
+
+func (idx *Indexer) handleMarket(w http.ResponseWriter, r *http.Request) {
+	row := idx.db.QueryRowContext(r.Context(), idx.dialect.rebind("SELECT "+marketColumns+" FROM markets WHERE market_id = ?"),
+		mux.Vars(r)["market_id"])
+	market, err := scanMarket(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		writeError(w, http.StatusNotFound, fmt.Errorf("market %s not found", mux.Vars(r)["market_id"]))
+		return
+	} else if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, market)
+}
+
+func (idx *Indexer) handleMarketTrades(w http.ResponseWriter, r *http.Request) {
+	q := newQuery(r)
+	q.where("market_id = ?", mux.Vars(r)["market_id"])
+	q.whereParam("outcome_index", "outcome_index")
+	q.timeRange("time")
+	serveList(w, r, idx, q, "SELECT "+tradeColumns+" FROM trades", "time, trade_id", scanTrade)
+}
+
+func (idx *Indexer) handleMarketVotes(w http.ResponseWriter, r *http.Request) {
+	q := newQuery(r)
+	q.where("market_id = ?", mux.Vars(r)["market_id"])
+	serveList(w, r, idx, q, "SELECT "+voteColumns+" FROM votes", "committed_height, voter", scanVote)
+}
+
+func (idx *Indexer) handleCandles(w http.ResponseWriter, r *http.Request) {
+	q := newQuery(r)
+	interval := r.URL.Query().Get("interval")
+	if interval == "" {
+		interval = strconv.FormatInt(CandleIntervals[0], 10)
+	}
+	q.where("market_id = ?", mux.Vars(r)["market_id"])
+	q.where("outcome_index = ?", mux.Vars(r)["outcome_index"])
+	q.where("interval_seconds = ?", interval)
+	q.timeRange("start_time")
+	serveList(w, r, idx, q, "SELECT "+candleColumns+" FROM candles", "start_time", scanCandle)
+}
+
+func (idx *Indexer) handleUserOrders(w http.ResponseWriter, r *http.Request) {
+	q := newQuery(r)
+	q.where("creator = ?", mux.Vars(r)["address"])
+	q.whereParam("market_id", "market_id")
+	q.whereParam("status", "status")
+	q.timeRange("created_at")
+	serveList(w, r, idx, q, "SELECT "+orderColumns+" FROM orders", "created_at, order_id", scanOrder)
+}
+
+// handleUserTrades lists the trades of an address on either side, across
+// all markets unless market_id is given
+func (idx *Indexer) handleUserTrades(w http.ResponseWriter, r *http.Request) {
+	address := mux.Vars(r)["address"]
+	q := newQuery(r)
+	q.where("(buyer = ? OR seller = ?)", address, address)
+	q.whereParam("market_id", "market_id")
+	q.timeRange("time")
+	serveList(w, r, idx, q, "SELECT "+tradeColumns+" FROM trades", "time, trade_id", scanTrade)
+}
+
+func (idx *Indexer) handleUserVotes(w http.ResponseWriter, r *http.Request) {
+	q := newQuery(r)
+	q.where("voter = ?", mux.Vars(r)["address"])
+	q.timeRange("committed_at")
+	serveList(w, r, idx, q, "SELECT "+voteColumns+" FROM votes", "committed_height, market_id", scanVote)
+}
+
+func (idx *Indexer) handleUserReputation(w http.ResponseWriter, r *http.Request) {
+	q := newQuery(r)
+	q.where("address = ?", mux.Vars(r)["address"])
+	q.whereParam("group_id", "group_id")
+	q.timeRange("time")
+	serveList(w, r, idx, q, "SELECT "+reputationColumns+" FROM reputation_changes", "height, event_index", scanReputationChange)
+}
+
+// query accumulates the filters of a list request
+type query struct {
+	r     *http.Request
+	conds []string
+	args  []any
+	err   error
+}
+
+func newQuery(r *http.Request) *query {
+	return &query{r: r}
+}
+
+func (q *query) where(cond string, args ...any) {
+	q.conds = append(q.conds, cond)
+	q.args = append(q.args, args...)
+}
+
+// whereParam filters column by the request parameter param, if present
+func (q *query) whereParam(param, column string) {
+	if v := q.r.URL.Query().Get(param); v != "" {
+		q.where(column+" = ?", v)
+	}
+}
+
+// timeRange filters column by the from and to request parameters, inclusive
+func (q *query) timeRange(column string) {
+	for param, op := range map[string]string{"from": ">=", "to": "<="} {
+		v := q.r.URL.Query().Get(param)
+		if v == "" {
+			continue
+		}
+		t, err := parseTime(v)
+		if err != nil {
+			q.err = fmt.Errorf("invalid %s: %w", param, err)
+			return
+		}
+		q.where(column+" "+op+" ?", t)
+	}
+}
+
+// page returns the limit and offset of the request
+func (q *query) page() (limit, offset int, err error) {
+	limit, offset = defaultLimit, 0
+	if v := q.r.URL.Query().Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit <= 0 {
+			return 0, 0, fmt.Errorf("invalid limit %q", v)
+		}
+		limit = min(limit, maxLimit)
+	}
+	if v := q.r.URL.Query().Get("offset"); v != "" {
+		if offset, err = strconv.Atoi(v); err != nil || offset < 0 {
+			return 0, 0, fmt.Errorf("invalid offset %q", v)
+		}
+	}
+	return limit, offset, nil
+}
+
+// serveList runs the filtered and paginated query and writes the scanned rows
+func serveList[T any](w http.ResponseWriter, r *http.Request, idx *Indexer, q *query, base, orderBy string, scan func(scanner) (T, error)) {
+	if q.err != nil {
+		writeError(w, http.StatusBadRequest, q.err)
+		return
+	}
+	limit, offset, err := q.page()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	stmt := base
+	if len(q.conds) > 0 {
+		stmt += " WHERE " + strings.Join(q.conds, " AND ")
+	}
+	stmt += fmt.Sprintf(" ORDER BY %s LIMIT %d OFFSET %d", orderBy, limit, offset)
+	items, err := queryRows(r.Context(), idx, stmt, q.args, scan)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, items)
+}
+
+func queryRows[T any](ctx context.Context, idx *Indexer, stmt string, args []any, scan func(scanner) (T, error)) ([]T, error) {
+	rows, err := idx.db.QueryContext(ctx, idx.dialect.rebind(stmt), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []T{}
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
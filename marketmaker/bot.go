@@ -0,0 +1,172 @@
+// Package marketmaker is a reference market maker for Speculo. It keeps
+// two-sided quotes around a fair value on the configured market outcomes,
+// skews them against the inventory it accumulates and replaces them whenever
+// the fair value moves or an order is filled. Every refresh sends one
+// transaction per outcome batching its cancellations and new orders, so an
+// outcome that fails to simulate does not hold up the others.
+package marketmaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/client"
+	predictiontypes "speculod/x/prediction/types"
+)
+
+// shutdownTimeout bounds the cancellation of the quotes once Run is stopped
+const shutdownTimeout = 30 * time.Second
+
+// Bot quotes the configured markets with the key named in the configuration
+type Bot struct {
+	client *client.Client
+	config Config
+	source FairValueSource
+	logger log.Logger
+	owner  string
+}
+
+// New returns a bot quoting the markets of config, which must be valid, with
+// fair values from source
+func New(c *client.Client, config Config, source FairValueSource, logger log.Logger) (*Bot, error) {
+	addr, err := c.Address(config.From)
+	if err != nil {
+		return nil, err
+	}
+	return &Bot{client: c, config: config, source: source, logger: logger, owner: addr.String()}, nil
+}
+
+// Run refreshes the quotes every refresh interval until ctx is done, then
+// cancels the open orders of the bot
+func (b *Bot) Run(ctx context.Context) error {
+	ticker := time.NewTicker(b.config.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := b.Refresh(ctx); err != nil && ctx.Err() == nil {
+			b.logger.Error("failed to refresh quotes", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			cancelCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			return b.CancelAll(cancelCtx)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh replaces the quotes that no longer match the fair value and
+// inventory of their outcome. Markets that stopped trading are skipped, and a
+// market whose fair value is unavailable keeps its quotes.
+func (b *Bot) Refresh(ctx context.Context) error {
+	return b.eachMarket(func(m MarketConfig) error {
+		msgs, err := b.requote(ctx, m)
+		if err != nil {
+			return err
+		}
+		return b.broadcast(ctx, msgs)
+	})
+}
+
+// requote returns the messages replacing the quotes of one outcome, none if
+// they are up to date
+func (b *Bot) requote(ctx context.Context, m MarketConfig) ([]sdk.Msg, error) {
+	res, err := b.client.Prediction.Market(ctx, &predictiontypes.QueryMarketRequest{MarketId: m.MarketId})
+	if err != nil {
+		return nil, err
+	}
+	if res.Market.Status != predictiontypes.MarketStatusOpen {
+		return nil, nil
+	}
+	fair, err := b.source.FairValue(ctx, m.MarketId, m.OutcomeIndex)
+	if err != nil {
+		return nil, fmt.Errorf("fair value: %w", err)
+	}
+	exposure, err := b.client.Exposure(ctx, m.MarketId, m.OutcomeIndex, b.owner)
+	if err != nil {
+		return nil, err
+	}
+	inventory := exposure.Position
+	quotes, err := m.Quotes(fair, inventory)
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := b.client.OpenOrders(ctx, m.MarketId, m.OutcomeIndex, b.owner)
+	if err != nil {
+		return nil, err
+	}
+	if sameQuotes(open, quotes) {
+		return nil, nil
+	}
+	b.logger.Info("requoting", "market", m.MarketId, "outcome", m.OutcomeIndex, "fair", fair, "inventory", inventory, "quotes", len(quotes))
+	msgs := b.cancelMsgs(open)
+	for _, q := range quotes {
+		amount := sdk.NewCoin(m.Denom, q.Amount)
+		msgs = append(msgs, &predictiontypes.MsgPostOrder{
+			Creator:      b.owner,
+			MarketId:     m.MarketId,
+			OutcomeIndex: m.OutcomeIndex,
+			Side:         q.Side,
+			Price:        q.Price,
+			Amount:       &amount,
+		})
+	}
+	return msgs, nil
+}
+
+// CancelAll cancels the open orders of the bot on the configured outcomes
+func (b *Bot) CancelAll(ctx context.Context) error {
+	return b.eachMarket(func(m MarketConfig) error {
+		open, err := b.client.OpenOrders(ctx, m.MarketId, m.OutcomeIndex, b.owner)
+		if err != nil {
+			return err
+		}
+		return b.broadcast(ctx, b.cancelMsgs(open))
+	})
+}
+
+// eachMarket runs fn concurrently on the configured outcomes and joins the
+// errors. The client signs the transactions of concurrent calls with
+// consecutive sequences.
+func (b *Bot) eachMarket(fn func(m MarketConfig) error) error {
+	errs := make([]error, len(b.config.Markets))
+	var wg sync.WaitGroup
+	for i, m := range b.config.Markets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(m); err != nil {
+				errs[i] = fmt.Errorf("market %d outcome %d: %w", m.MarketId, m.OutcomeIndex, err)
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func (b *Bot) cancelMsgs(orders []predictiontypes.Order) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(orders))
+	for _, order := range orders {
+		msgs = append(msgs, &predictiontypes.MsgCancelOrder{Creator: b.owner, OrderId: order.Id})
+	}
+	return msgs
+}
+
+func (b *Bot) broadcast(ctx context.Context, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	res, err := b.client.BroadcastTx(ctx, b.config.From, msgs...)
+	if err != nil {
+		return err
+	}
+	b.logger.Debug("broadcast quotes", "tx", res.TxHash, "msgs", len(msgs))
+	return nil
+}
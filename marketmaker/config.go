@@ -0,0 +1,303 @@
+package marketmaker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// Fair value sources
+const (
+	SourceConstant = "constant"
+	SourceFile     = "file"
+	SourceTWAP     = "twap"
+)
+
+// Config is the configuration of the bot, read from config/mm.toml in its
+// home directory
+type Config struct {
+	GRPCAddress     string        `mapstructure:"grpc-address"`
+	ChainID         string        `mapstructure:"chain-id"`
+	From            string        `mapstructure:"from"`
+	KeyringBackend  string        `mapstructure:"keyring-backend"`
+	KeyringDir      string        `mapstructure:"keyring-dir"`
+	GasPrices       string        `mapstructure:"gas-prices"`
+	GasAdjustment   float64       `mapstructure:"gas-adjustment"`
+	RefreshInterval time.Duration `mapstructure:"refresh-interval"`
+
+	FairValue FairValueConfig `mapstructure:"fair-value"`
+	Markets   []MarketConfig  `mapstructure:"markets"`
+}
+
+// FairValueConfig selects where the fair value of the quoted outcomes comes
+// from
+type FairValueConfig struct {
+	// Source is constant, file or twap
+	Source string `mapstructure:"source"`
+	// Value is the constant fair value, and the fallback of the twap source
+	// while an outcome has not traded yet
+	Value string `mapstructure:"value"`
+	// File is the JSON file of the file source
+	File string `mapstructure:"file"`
+	// TWAPWindow is the averaging window of the twap source
+	TWAPWindow time.Duration `mapstructure:"twap-window"`
+}
+
+// MarketConfig is the quoting configuration of one outcome of a market
+type MarketConfig struct {
+	MarketId     uint64 `mapstructure:"market-id"`
+	OutcomeIndex uint32 `mapstructure:"outcome-index"`
+	Denom        string `mapstructure:"denom"`
+	// Size is the amount of every quote
+	Size string `mapstructure:"size"`
+	// Spread is the distance between the best bid and the best ask
+	Spread string `mapstructure:"spread"`
+	// Levels is the number of quotes on each side, LevelStep apart
+	Levels    int    `mapstructure:"levels"`
+	LevelStep string `mapstructure:"level-step"`
+	// Tick is the price increment, bids are rounded down and asks up to it
+	Tick string `mapstructure:"tick"`
+	// MaxInventory is the net filled amount at which the bot stops quoting
+	// the side adding to its position
+	MaxInventory string `mapstructure:"max-inventory"`
+	// Skew is the price shift applied to the quotes at MaxInventory, lowering
+	// them when long and raising them when short
+	Skew string `mapstructure:"skew"`
+}
+
+// DefaultConfig returns the configuration written on the first start. It
+// quotes no market.
+func DefaultConfig() Config {
+	return Config{
+		GRPCAddress:     "localhost:9090",
+		KeyringBackend:  "os",
+		GasPrices:       "0stake",
+		GasAdjustment:   1.5,
+		RefreshInterval: 10 * time.Second,
+		FairValue: FairValueConfig{
+			Source:     SourceConstant,
+			Value:      "0.5",
+			TWAPWindow: time.Hour,
+		},
+	}
+}
+
+// DefaultMarketConfig returns the quoting parameters used for the fields a
+// market entry leaves empty
+func DefaultMarketConfig() MarketConfig {
+	return MarketConfig{
+		Denom:        "stake",
+		Size:         "100",
+		Spread:       "0.04",
+		Levels:       1,
+		LevelStep:    "0.01",
+		Tick:         "0.01",
+		MaxInventory: "1000",
+		Skew:         "0.02",
+	}
+}
+
+// Validate checks the configuration and fills the empty market fields with
+// their defaults
+func (c *Config) Validate() error {
+	if c.From == "" {
+		return errors.New("from must name the key of the bot")
+	}
+	if c.RefreshInterval <= 0 {
+		return errors.New("refresh-interval must be positive")
+	}
+	switch c.FairValue.Source {
+	case SourceConstant, SourceTWAP:
+		if _, err := parsePrice("fair-value.value", c.FairValue.Value); err != nil {
+			return err
+		}
+	case SourceFile:
+		if c.FairValue.File == "" {
+			return errors.New("fair-value.file is required by the file source")
+		}
+	default:
+		return fmt.Errorf("unknown fair value source %q", c.FairValue.Source)
+	}
+	if len(c.Markets) == 0 {
+		return errors.New("no market to quote, add [[markets]] entries to the configuration")
+	}
+	for i := range c.Markets {
+		c.Markets[i].setDefaults()
+		if _, err := c.Markets[i].params(); err != nil {
+			return fmt.Errorf("market %d outcome %d: %w", c.Markets[i].MarketId, c.Markets[i].OutcomeIndex, err)
+		}
+	}
+	return nil
+}
+
+func (m *MarketConfig) setDefaults() {
+	d := DefaultMarketConfig()
+	for _, f := range []struct{ v, d *string }{
+		{&m.Denom, &d.Denom},
+		{&m.Size, &d.Size},
+		{&m.Spread, &d.Spread},
+		{&m.LevelStep, &d.LevelStep},
+		{&m.Tick, &d.Tick},
+		{&m.MaxInventory, &d.MaxInventory},
+		{&m.Skew, &d.Skew},
+	} {
+		if *f.v == "" {
+			*f.v = *f.d
+		}
+	}
+	if m.Levels == 0 {
+		m.Levels = d.Levels
+	}
+}
+
+// quoteParams are the parsed quoting parameters of a market
+type quoteParams struct {
+	size         math.Int
+	spread       math.LegacyDec
+	levels       int
+	levelStep    math.LegacyDec
+	tick         math.LegacyDec
+	maxInventory math.Int
+	skew         math.LegacyDec
+}
+
+func (m MarketConfig) params() (quoteParams, error) {
+	var (
+		p   quoteParams
+		err error
+		ok  bool
+	)
+	if p.size, ok = math.NewIntFromString(m.Size); !ok || !p.size.IsPositive() {
+		return p, fmt.Errorf("size must be a positive integer, got %q", m.Size)
+	}
+	if p.maxInventory, ok = math.NewIntFromString(m.MaxInventory); !ok || p.maxInventory.IsNegative() {
+		return p, fmt.Errorf("max-inventory must be a non-negative integer, got %q", m.MaxInventory)
+	}
+	if m.Levels <= 0 {
+		return p, fmt.Errorf("levels must be positive, got %d", m.Levels)
+	}
+	p.levels = m.Levels
+	if p.spread, err = parsePrice("spread", m.Spread); err != nil {
+		return p, err
+	}
+	if p.tick, err = parsePrice("tick", m.Tick); err != nil {
+		return p, err
+	}
+	if p.levelStep, err = math.LegacyNewDecFromStr(m.LevelStep); err != nil || p.levelStep.IsNegative() {
+		return p, fmt.Errorf("level-step must be a non-negative decimal, got %q", m.LevelStep)
+	}
+	if p.skew, err = math.LegacyNewDecFromStr(m.Skew); err != nil || p.skew.IsNegative() {
+		return p, fmt.Errorf("skew must be a non-negative decimal, got %q", m.Skew)
+	}
+	return p, nil
+}
+
+// parsePrice parses a decimal strictly between 0 and 1
+func parsePrice(name, s string) (math.LegacyDec, error) {
+	d, err := math.LegacyNewDecFromStr(s)
+	if err != nil || !d.IsPositive() || d.GTE(math.LegacyOneDec()) {
+		return math.LegacyDec{}, fmt.Errorf("%s must be a decimal between 0 and 1, got %q", name, s)
+	}
+	return d, nil
+}
+
+// DefaultConfigTemplate is the template of config/mm.toml
+const DefaultConfigTemplate = `# This is a TOML config file.
+# For more information, see https://github.com/toml-lang/toml
+
+###############################################################################
+###                               Connection                                ###
+###############################################################################
+
+# gRPC address of the node.
+grpc-address = "{{ .GRPCAddress }}"
+
+# Chain ID the transactions are signed for, queried from the node when empty.
+chain-id = "{{ .ChainID }}"
+
+# Name of the key quoting the markets.
+from = "{{ .From }}"
+
+# Keyring backend (os|file|kwallet|pass|test|memory) and directory. The
+# directory defaults to the home directory of the bot.
+keyring-backend = "{{ .KeyringBackend }}"
+keyring-dir = "{{ .KeyringDir }}"
+
+# Gas prices the fees are paid with, and the factor applied to simulated gas.
+gas-prices = "{{ .GasPrices }}"
+gas-adjustment = {{ .GasAdjustment }}
+
+# How often the quotes are checked against the fair value and the fills.
+refresh-interval = "{{ .RefreshInterval }}"
+
+###############################################################################
+###                               Fair Value                                ###
+###############################################################################
+
+[fair-value]
+
+# Source of the fair value: constant, file or twap.
+source = "{{ .FairValue.Source }}"
+
+# Fair value of the constant source, and fallback of the twap source for the
+# outcomes that have not traded yet.
+value = "{{ .FairValue.Value }}"
+
+# JSON file of the file source, mapping "<market-id>/<outcome-index>" to a
+# price, e.g. {"1/0": "0.62"}. It is read again on every refresh.
+file = "{{ .FairValue.File }}"
+
+# Averaging window of the twap source.
+twap-window = "{{ .FairValue.TWAPWindow }}"
+
+###############################################################################
+###                                 Markets                                 ###
+###############################################################################
+
+# One [[markets]] entry per quoted outcome. Empty fields take the values of
+# this example.
+#
+# [[markets]]
+# market-id = 1
+# outcome-index = 0
+# denom = "stake"
+# size = "100"
+# spread = "0.04"
+# levels = 1
+# level-step = "0.01"
+# tick = "0.01"
+# max-inventory = "1000"
+# skew = "0.02"
+{{ range .Markets }}
+[[markets]]
+market-id = {{ .MarketId }}
+outcome-index = {{ .OutcomeIndex }}
+denom = "{{ .Denom }}"
+size = "{{ .Size }}"
+spread = "{{ .Spread }}"
+levels = {{ .Levels }}
+level-step = "{{ .LevelStep }}"
+tick = "{{ .Tick }}"
+max-inventory = "{{ .MaxInventory }}"
+skew = "{{ .Skew }}"
+{{ end }}`
+
+var configTemplate = template.Must(template.New("mm").Parse(DefaultConfigTemplate))
+
+// WriteConfigFile renders config into the file at path
+func WriteConfigFile(path string, config Config) error {
+	var buf bytes.Buffer
+	if err := configTemplate.Execute(&buf, config); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o600)
+}
@@ -0,0 +1,93 @@
+package marketmaker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"cosmossdk.io/math"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	predictiontypes "speculod/x/prediction/types"
+)
+
+// FairValueSource gives the price the quotes of an outcome are centered on
+type FairValueSource interface {
+	FairValue(ctx context.Context, marketId uint64, outcomeIndex uint32) (math.LegacyDec, error)
+}
+
+// ConstantSource quotes every outcome around the same value
+type ConstantSource math.LegacyDec
+
+// FairValue implements FairValueSource
+func (s ConstantSource) FairValue(context.Context, uint64, uint32) (math.LegacyDec, error) {
+	return math.LegacyDec(s), nil
+}
+
+// FileSource reads the fair values from a JSON object mapping
+// "<market-id>/<outcome-index>" to a price. The file is read on every call,
+// so it can be updated while the bot runs.
+type FileSource string
+
+// FairValue implements FairValueSource
+func (s FileSource) FairValue(_ context.Context, marketId uint64, outcomeIndex uint32) (math.LegacyDec, error) {
+	bz, err := os.ReadFile(string(s))
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	var values map[string]string
+	if err := json.Unmarshal(bz, &values); err != nil {
+		return math.LegacyDec{}, fmt.Errorf("parse %s: %w", string(s), err)
+	}
+	key := fmt.Sprintf("%d/%d", marketId, outcomeIndex)
+	value, ok := values[key]
+	if !ok {
+		return math.LegacyDec{}, fmt.Errorf("no fair value for %s in %s", key, string(s))
+	}
+	return parsePrice(key, value)
+}
+
+// TWAPSource uses the time-weighted average price of the outcome itself. The
+// fallback is used for outcomes without trades yet, such as the markets of a
+// new group.
+type TWAPSource struct {
+	Query    predictiontypes.QueryClient
+	Window   time.Duration
+	Fallback math.LegacyDec
+}
+
+// FairValue implements FairValueSource
+func (s TWAPSource) FairValue(ctx context.Context, marketId uint64, outcomeIndex uint32) (math.LegacyDec, error) {
+	res, err := s.Query.TWAP(ctx, &predictiontypes.QueryTWAPRequest{
+		MarketId:      marketId,
+		OutcomeIndex:  outcomeIndex,
+		WindowSeconds: int64(s.Window.Seconds()),
+	})
+	if err != nil {
+		// The query reports an outcome without price history as NotFound
+		if status.Code(err) == codes.NotFound {
+			return s.Fallback, nil
+		}
+		return math.LegacyDec{}, err
+	}
+	return parsePrice("twap", res.Twap)
+}
+
+// NewFairValueSource returns the source selected by config
+func NewFairValueSource(config FairValueConfig, query predictiontypes.QueryClient) (FairValueSource, error) {
+	switch config.Source {
+	case SourceConstant:
+		value, err := parsePrice("fair-value.value", config.Value)
+		return ConstantSource(value), err
+	case SourceFile:
+		return FileSource(config.File), nil
+	case SourceTWAP:
+		value, err := parsePrice("fair-value.value", config.Value)
+		return TWAPSource{Query: query, Window: config.TWAPWindow, Fallback: value}, err
+	default:
+		return nil, fmt.Errorf("unknown fair value source %q", config.Source)
+	}
+}
@@ -0,0 +1,112 @@
+package marketmaker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"speculod/client"
+	"speculod/marketmaker"
+	"speculod/testutil/network"
+	predictiontypes "speculod/x/prediction/types"
+)
+
+// openQuotes returns the side and price of the open orders of owner
+func openQuotes(t *testing.T, ctx context.Context, c *client.Client, owner string) []string {
+	t.Helper()
+	orders, err := c.UserOrders(ctx, owner)
+	require.NoError(t, err)
+	var quotes []string
+	for _, order := range orders {
+		if order.Status == predictiontypes.ORDER_STATUS_OPEN || order.Status == predictiontypes.ORDER_STATUS_PARTIALLY_FILLED {
+			quotes = append(quotes, order.Side.String()+" "+order.Price)
+		}
+	}
+	return quotes
+}
+
+func TestBotNetwork(t *testing.T) {
+	cfg := network.DefaultConfig()
+	net := network.New(t, cfg)
+	val := net.Validators[0]
+	conn, err := grpc.NewClient(val.AppConfig.GRPC.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	kr := val.ClientCtx.Keyring
+	c, err := client.New(ctx, conn, kr,
+		client.WithGasPrices(cfg.MinGasPrices),
+		client.WithPollInterval(100*time.Millisecond))
+	require.NoError(t, err)
+
+	// The validator key quotes, a second funded key takes liquidity
+	taker, _, err := kr.NewMnemonic("taker", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	takerAddr, err := taker.GetAddress()
+	require.NoError(t, err)
+	_, err = c.BroadcastTx(ctx, val.Moniker, banktypes.NewMsgSend(val.Address, takerAddr, sdk.NewCoins(sdk.NewInt64Coin(cfg.BondDenom, 1_000_000))))
+	require.NoError(t, err)
+
+	marketId, err := c.CreateMarket(ctx, val.Moniker, predictiontypes.MsgCreateMarket{
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	config := marketmaker.DefaultConfig()
+	config.From = val.Moniker
+	config.RefreshInterval = time.Hour
+	// The orders on the missing outcome 5 fail, without holding up outcome 0
+	config.Markets = []marketmaker.MarketConfig{
+		{MarketId: marketId, Denom: cfg.BondDenom},
+		{MarketId: marketId, OutcomeIndex: 5, Denom: cfg.BondDenom},
+	}
+	require.NoError(t, config.Validate())
+	bot, err := marketmaker.New(c, config, marketmaker.ConstantSource(math.LegacyMustNewDecFromStr("0.5")), log.NewNopLogger())
+	require.NoError(t, err)
+	owner := val.Address.String()
+
+	refresh := func() {
+		t.Helper()
+		err := bot.Refresh(ctx)
+		require.ErrorContains(t, err, "outcome 5")
+		require.NotContains(t, err.Error(), "outcome 0")
+	}
+	refresh()
+	require.ElementsMatch(t, []string{"ORDER_SIDE_BUY 0.48", "ORDER_SIDE_SELL 0.52"}, openQuotes(t, ctx, c, owner))
+	orders, err := c.UserOrders(ctx, owner)
+	require.NoError(t, err)
+
+	// Unchanged quotes are left alone
+	refresh()
+	again, err := c.UserOrders(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, orders, again)
+
+	// Once its bid is filled the bot is long and shifts its quotes down
+	_, err = c.PostOrder(ctx, "taker", marketId, 0, "SELL", "0.48", sdk.NewInt64Coin(cfg.BondDenom, 100))
+	require.NoError(t, err)
+	refresh()
+	require.ElementsMatch(t, []string{"ORDER_SIDE_BUY 0.47", "ORDER_SIDE_SELL 0.52"}, openQuotes(t, ctx, c, owner))
+
+	// Stopping the bot cancels its quotes
+	runCtx, stop := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() { done <- bot.Run(runCtx) }()
+	stop()
+	require.NoError(t, <-done)
+	require.Empty(t, openQuotes(t, ctx, c, owner))
+}
@@ -0,0 +1,95 @@
+package marketmaker
+
+import (
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	predictiontypes "speculod/x/prediction/types"
+)
+
+// Order sides of MsgPostOrder
+const (
+	SideBuy  = "BUY"
+	SideSell = "SELL"
+)
+
+// Quote is an order the bot wants resting on the book
+type Quote struct {
+	Side   string
+	Price  string
+	Amount math.Int
+}
+
+// Quotes returns the bids and asks of the market around fair for the given
+// net inventory. The quotes are shifted against the inventory by up to Skew,
+// and the side adding to the position is dropped once the inventory reaches
+// MaxInventory. Prices stay on the tick grid within (0, 1).
+func (m MarketConfig) Quotes(fair math.LegacyDec, inventory math.Int) ([]Quote, error) {
+	p, err := m.params()
+	if err != nil {
+		return nil, err
+	}
+
+	center := fair
+	if p.maxInventory.IsPositive() {
+		ratio := math.LegacyNewDecFromInt(inventory).QuoInt(p.maxInventory)
+		ratio = math.LegacyMinDec(math.LegacyMaxDec(ratio, math.LegacyOneDec().Neg()), math.LegacyOneDec())
+		center = center.Sub(p.skew.Mul(ratio))
+	}
+	bids := !p.maxInventory.IsPositive() || inventory.LT(p.maxInventory)
+	asks := !p.maxInventory.IsPositive() || inventory.GT(p.maxInventory.Neg())
+
+	half := p.spread.QuoInt64(2)
+	maxPrice := math.LegacyOneDec().Sub(p.tick)
+	var quotes []Quote
+	for i := range p.levels {
+		offset := half.Add(p.levelStep.MulInt64(int64(i)))
+		if bid := center.Sub(offset).Quo(p.tick).TruncateDec().Mul(p.tick); bids && bid.GTE(p.tick) {
+			quotes = append(quotes, Quote{Side: SideBuy, Price: formatPrice(bid), Amount: p.size})
+		}
+		if ask := center.Add(offset).Quo(p.tick).Ceil().Mul(p.tick); asks && ask.LTE(maxPrice) {
+			quotes = append(quotes, Quote{Side: SideSell, Price: formatPrice(ask), Amount: p.size})
+		}
+	}
+	return quotes, nil
+}
+
+// formatPrice trims the trailing zeros of a decimal, "0.450000000000000000"
+// becomes "0.45"
+func formatPrice(d math.LegacyDec) string {
+	return strings.TrimSuffix(strings.TrimRight(d.String(), "0"), ".")
+}
+
+// sameQuotes reports whether the open orders are exactly the wanted quotes,
+// with nothing filled yet
+func sameQuotes(open []predictiontypes.Order, quotes []Quote) bool {
+	if len(open) != len(quotes) {
+		return false
+	}
+	key := func(side, price string, amount math.Int) string {
+		return side + "@" + price + "x" + amount.String()
+	}
+	have := make([]string, 0, len(open))
+	for _, order := range open {
+		price, err := math.LegacyNewDecFromStr(order.Price)
+		if err != nil || order.Amount == nil || order.FilledAmount == nil {
+			return false
+		}
+		side := strings.TrimPrefix(order.Side.String(), "ORDER_SIDE_")
+		have = append(have, key(side, formatPrice(price), order.Amount.Amount.Sub(order.FilledAmount.Amount)))
+	}
+	want := make([]string, 0, len(quotes))
+	for _, q := range quotes {
+		want = append(want, key(q.Side, q.Price, q.Amount))
+	}
+	sort.Strings(have)
+	sort.Strings(want)
+	for i := range have {
+		if have[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package marketmaker_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/marketmaker"
+	predictiontypes "speculod/x/prediction/types"
+)
+
+func market() marketmaker.MarketConfig {
+	m := marketmaker.DefaultMarketConfig()
+	m.MarketId = 1
+	m.Levels = 2
+	return m
+}
+
+func prices(quotes []marketmaker.Quote) []string {
+	var prices []string
+	for _, q := range quotes {
+		prices = append(prices, q.Side+" "+q.Price)
+	}
+	return prices
+}
+
+func TestQuotes(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		fair      string
+		inventory int64
+		want      []string
+	}{
+		{"flat", "0.5", 0, []string{"BUY 0.48", "SELL 0.52", "BUY 0.47", "SELL 0.53"}},
+		{"rounded away from fair", "0.505", 0, []string{"BUY 0.48", "SELL 0.53", "BUY 0.47", "SELL 0.54"}},
+		// Half the maximum inventory shifts the quotes by half the skew
+		{"long", "0.5", 500, []string{"BUY 0.47", "SELL 0.51", "BUY 0.46", "SELL 0.52"}},
+		{"short", "0.5", -500, []string{"BUY 0.49", "SELL 0.53", "BUY 0.48", "SELL 0.54"}},
+		{"max long", "0.5", 1000, []string{"SELL 0.5", "SELL 0.51"}},
+		{"beyond max short", "0.5", -5000, []string{"BUY 0.5", "BUY 0.49"}},
+		// Prices stay within (0, 1)
+		{"near zero", "0.02", 0, []string{"SELL 0.04", "SELL 0.05"}},
+		{"near one", "0.985", 0, []string{"BUY 0.96", "BUY 0.95"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			quotes, err := market().Quotes(math.LegacyMustNewDecFromStr(tc.fair), math.NewInt(tc.inventory))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, prices(quotes))
+			for _, q := range quotes {
+				require.Equal(t, int64(100), q.Amount.Int64())
+			}
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := marketmaker.DefaultConfig()
+	config.From = "mm"
+	require.ErrorContains(t, config.Validate(), "no market")
+
+	// Empty market fields take the defaults
+	config.Markets = []marketmaker.MarketConfig{{MarketId: 4, OutcomeIndex: 1, Spread: "0.1"}}
+	require.NoError(t, config.Validate())
+	want := marketmaker.DefaultMarketConfig()
+	want.MarketId, want.OutcomeIndex, want.Spread = 4, 1, "0.1"
+	require.Equal(t, want, config.Markets[0])
+
+	for _, bad := range []func(*marketmaker.MarketConfig){
+		func(m *marketmaker.MarketConfig) { m.Spread = "1" },
+		func(m *marketmaker.MarketConfig) { m.Size = "-1" },
+		func(m *marketmaker.MarketConfig) { m.Tick = "zero" },
+		func(m *marketmaker.MarketConfig) { m.Skew = "-0.1" },
+		func(m *marketmaker.MarketConfig) { m.Levels = -1 },
+	} {
+		c := config
+		c.Markets = []marketmaker.MarketConfig{config.Markets[0]}
+		bad(&c.Markets[0])
+		require.Error(t, c.Validate())
+	}
+
+	config.FairValue.Source = "oracle"
+	require.ErrorContains(t, config.Validate(), "unknown fair value source")
+}
+
+func TestFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fair.json")
+	source := marketmaker.FileSource(path)
+	ctx := context.Background()
+
+	_, err := source.FairValue(ctx, 1, 0)
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"1/0": "0.62", "1/1": "1.5"}`), 0o600))
+	value, err := source.FairValue(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.62"), value)
+	_, err = source.FairValue(ctx, 1, 1)
+	require.ErrorContains(t, err, "between 0 and 1")
+	_, err = source.FairValue(ctx, 2, 0)
+	require.ErrorContains(t, err, "no fair value for 2/0")
+
+	// The file is read again on every call
+	require.NoError(t, os.WriteFile(path, []byte(`{"1/0": "0.3"}`), 0o600))
+	value, err = source.FairValue(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.3"), value)
+}
+
+// twapQuery answers the TWAP query with a fixed result
+type twapQuery struct {
+	predictiontypes.QueryClient
+	twap string
+	err  error
+}
+
+func (q twapQuery) TWAP(context.Context, *predictiontypes.QueryTWAPRequest, ...grpc.CallOption) (*predictiontypes.QueryTWAPResponse, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &predictiontypes.QueryTWAPResponse{Twap: q.twap}, nil
+}
+
+func TestTWAPSource(t *testing.T) {
+	ctx := context.Background()
+	fallback := math.LegacyMustNewDecFromStr("0.5")
+
+	source := marketmaker.TWAPSource{Query: twapQuery{twap: "0.42"}, Window: time.Hour, Fallback: fallback}
+	value, err := source.FairValue(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.42"), value)
+
+	// Outcomes without trades use the fallback, other errors are returned
+	source.Query = twapQuery{err: status.Error(codes.NotFound, "no price history for market 1 outcome 0")}
+	value, err = source.FairValue(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, fallback, value)
+	source.Query = twapQuery{err: status.Error(codes.Unavailable, "connection refused")}
+	_, err = source.FairValue(ctx, 1, 0)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
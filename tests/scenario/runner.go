@@ -0,0 +1,596 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"speculod/app"
+	predictiontypes "speculod/x/prediction/types"
+	reputationtypes "speculod/x/reputation/types"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+const (
+	// ChainID is the chain ID of the chain scenarios run on
+	ChainID = "speculod-scenario"
+	// BlockInterval is the time between the blocks of two consecutive steps
+	BlockInterval = 5 * time.Second
+)
+
+// DefaultGenesisTime is the time of the first block of a scenario without one
+var DefaultGenesisTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Runner executes the steps of a scenario on an in-process chain
+type Runner struct {
+	app    *app.App
+	valSet *cmttypes.ValidatorSet
+	rand   *rand.Rand
+	time   time.Time
+
+	keys    map[string]cryptotypes.PrivKey
+	markets map[string]uint64
+	orders  map[string]uint64
+	// votes are the committed vote and nonce of each "market/account"
+	votes map[string]Vote
+}
+
+// Run executes a scenario on a fresh chain, returning the first step that
+// failed or whose expectations were not met
+func Run(s Scenario) error {
+	r, err := NewRunner(s)
+	if err != nil {
+		return err
+	}
+	for i, step := range s.Steps {
+		action, err := step.action()
+		if err != nil {
+			return fmt.Errorf("step %d: %w", i+1, err)
+		}
+		if err := r.Step(step); err != nil {
+			return fmt.Errorf("step %d (%s): %w", i+1, action, err)
+		}
+	}
+	return nil
+}
+
+// NewRunner starts a chain with the accounts and reputation of a scenario at
+// genesis and commits its first block
+func NewRunner(s Scenario) (*Runner, error) {
+	r := &Runner{
+		app: app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{},
+			baseapp.SetChainID(ChainID)),
+		rand:    rand.New(rand.NewSource(1)),
+		time:    s.GenesisTime,
+		keys:    make(map[string]cryptotypes.PrivKey),
+		markets: make(map[string]uint64),
+		orders:  make(map[string]uint64),
+		votes:   make(map[string]Vote),
+	}
+	if r.time.IsZero() {
+		r.time = DefaultGenesisTime
+	}
+	valSet, err := simtestutil.CreateRandomValidatorSet()
+	if err != nil {
+		return nil, err
+	}
+	r.valSet = valSet
+
+	// Accounts are derived from their names so that scenarios are reproducible
+	names := make([]string, 0, len(s.Accounts))
+	for name := range s.Accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var (
+		accounts []authtypes.GenesisAccount
+		balances []banktypes.Balance
+	)
+	for _, name := range names {
+		key := secp256k1.GenPrivKeyFromSecret([]byte(name))
+		r.keys[name] = key
+		addr := sdk.AccAddress(key.PubKey().Address())
+		accounts = append(accounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
+		coins, err := sdk.ParseCoinsNormalized(s.Accounts[name])
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", name, err)
+		}
+		if !coins.IsZero() {
+			balances = append(balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+		}
+	}
+
+	cdc := r.app.AppCodec()
+	genesis, err := simtestutil.GenesisStateWithValSet(cdc, r.app.DefaultGenesis(), valSet, accounts, balances...)
+	if err != nil {
+		return nil, err
+	}
+	var reputation reputationtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[reputationtypes.ModuleName], &reputation)
+	for key, score := range s.Reputation {
+		addr, group, err := r.scoreKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("reputation: %w", err)
+		}
+		reputation.ReputationScores = append(reputation.ReputationScores, reputationtypes.ReputationScore{
+			Address: addr,
+			GroupId: group,
+			Score:   strconv.FormatInt(score, 10),
+		})
+	}
+	genesis[reputationtypes.ModuleName] = cdc.MustMarshalJSON(&reputation)
+	state, err := cmtjson.MarshalIndent(genesis, "", " ")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.app.InitChain(&abci.RequestInitChain{
+		ChainId:         ChainID,
+		Time:            r.time,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   state,
+		InitialHeight:   1,
+	}); err != nil {
+		return nil, fmt.Errorf("init chain: %w", err)
+	}
+	if _, err := r.nextBlock(0); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Step executes a single step of a scenario
+func (r *Runner) Step(step Step) error {
+	err := r.step(step)
+	switch {
+	case step.Error == "":
+		return err
+	case err == nil:
+		return fmt.Errorf("expected error %q", step.Error)
+	case !strings.Contains(err.Error(), step.Error):
+		return fmt.Errorf("expected error %q, got %w", step.Error, err)
+	default:
+		return nil
+	}
+}
+
+func (r *Runner) step(step Step) error {
+	switch {
+	case step.CreateMarket != nil:
+		return r.createMarket(*step.CreateMarket)
+	case step.CreateTemplate != nil:
+		return r.createTemplate(*step.CreateTemplate)
+	case step.PostOrder != nil:
+		return r.postOrder(*step.PostOrder)
+	case step.CancelOrder != nil:
+		return r.cancelOrder(*step.CancelOrder)
+	case step.Commit != nil:
+		return r.commit(*step.Commit)
+	case step.Reveal != nil:
+		return r.reveal(*step.Reveal)
+	case step.Finalize != nil:
+		return r.finalize(*step.Finalize)
+	case step.AdvanceTime != "":
+		d, err := parseDuration(step.AdvanceTime)
+		if err != nil {
+			return err
+		}
+		_, err = r.nextBlock(d)
+		return err
+	case step.Expect != nil:
+		return r.expect(*step.Expect)
+	}
+	return errors.New("step has no action")
+}
+
+func (r *Runner) createMarket(m CreateMarket) error {
+	deadline, err := parseDuration(m.Deadline)
+	if err != nil {
+		return err
+	}
+	creator, err := r.address(m.As)
+	if err != nil {
+		return err
+	}
+	var res predictiontypes.MsgCreateMarketResponse
+	if err := r.deliver(m.As, &predictiontypes.MsgCreateMarket{
+		Creator:  creator,
+		Question: m.Question,
+		Outcomes: m.Outcomes,
+		// The market is created in the next block
+		Deadline: r.time.Add(BlockInterval + deadline).Unix(),
+	}, &res); err != nil {
+		return err
+	}
+	if m.Ref != "" {
+		r.markets[m.Ref] = res.MarketId
+	}
+	return nil
+}
+
+func (r *Runner) createTemplate(t CreateTemplate) error {
+	duration, err := parseDuration(t.Duration)
+	if err != nil {
+		return err
+	}
+	creator, err := r.address(t.As)
+	if err != nil {
+		return err
+	}
+	msg := &predictiontypes.MsgCreateMarketTemplate{
+		Creator:         creator,
+		Question:        t.Question,
+		Outcomes:        t.Outcomes,
+		Duration:        int64(duration / time.Second),
+		EpochIdentifier: t.Epoch,
+		Recurrence:      t.Recurrence,
+		RollBond:        t.RollBond,
+	}
+	if t.Bond != "" {
+		bond, err := sdk.ParseCoinNormalized(t.Bond)
+		if err != nil {
+			return err
+		}
+		msg.Bond = &bond
+	}
+	return r.deliver(t.As, msg, nil)
+}
+
+func (r *Runner) postOrder(o PostOrder) error {
+	marketId, err := r.market(o.Market)
+	if err != nil {
+		return err
+	}
+	creator, err := r.address(o.As)
+	if err != nil {
+		return err
+	}
+	amount, err := sdk.ParseCoinNormalized(o.Amount)
+	if err != nil {
+		return err
+	}
+	var res predictiontypes.MsgPostOrderResponse
+	if err := r.deliver(o.As, &predictiontypes.MsgPostOrder{
+		Creator:      creator,
+		MarketId:     marketId,
+		OutcomeIndex: o.Outcome,
+		Side:         o.Side,
+		Price:        o.Price,
+		Amount:       &amount,
+	}, &res); err != nil {
+		return err
+	}
+	if o.Ref != "" {
+		r.orders[o.Ref] = res.OrderId
+	}
+	return nil
+}
+
+func (r *Runner) cancelOrder(c CancelOrder) error {
+	orderId, err := r.order(c.Order)
+	if err != nil {
+		return err
+	}
+	creator, err := r.address(c.As)
+	if err != nil {
+		return err
+	}
+	return r.deliver(c.As, &predictiontypes.MsgCancelOrder{Creator: creator, OrderId: orderId}, nil)
+}
+
+func (r *Runner) commit(v Vote) error {
+	marketId, err := r.market(v.Market)
+	if err != nil {
+		return err
+	}
+	creator, err := r.address(v.As)
+	if err != nil {
+		return err
+	}
+	if err := r.deliver(v.As, &settlementtypes.MsgCommitVote{
+		Creator:    creator,
+		MarketId:   marketId,
+		Commitment: settlementtypes.VoteCommitment(v.Vote, v.Nonce),
+	}, nil); err != nil {
+		return err
+	}
+	r.votes[fmt.Sprintf("%d/%s", marketId, v.As)] = v
+	return nil
+}
+
+func (r *Runner) reveal(v Vote) error {
+	marketId, err := r.market(v.Market)
+	if err != nil {
+		return err
+	}
+	creator, err := r.address(v.As)
+	if err != nil {
+		return err
+	}
+	if committed, ok := r.votes[fmt.Sprintf("%d/%s", marketId, v.As)]; ok && v.Vote == "" && v.Nonce == "" {
+		v.Vote, v.Nonce = committed.Vote, committed.Nonce
+	}
+	return r.deliver(v.As, &settlementtypes.MsgRevealVote{
+		Creator:  creator,
+		MarketId: marketId,
+		Vote:     v.Vote,
+		Nonce:    v.Nonce,
+	}, nil)
+}
+
+func (r *Runner) finalize(f Finalize) error {
+	marketId, err := r.market(f.Market)
+	if err != nil {
+		return err
+	}
+	creator, err := r.address(f.As)
+	if err != nil {
+		return err
+	}
+	return r.deliver(f.As, &settlementtypes.MsgFinalizeOutcome{Creator: creator, MarketId: marketId}, nil)
+}
+
+// deliver signs msg with the key of an account and delivers it in the next
+// block, decoding the message response into res unless it is nil
+func (r *Runner) deliver(from string, msg sdk.Msg, res proto.Message) error {
+	key, ok := r.keys[from]
+	if !ok {
+		return fmt.Errorf("unknown account %s", from)
+	}
+	acc := r.app.AuthKeeper.GetAccount(r.context(), sdk.AccAddress(key.PubKey().Address()))
+	if acc == nil {
+		return fmt.Errorf("account %s does not exist", from)
+	}
+	tx, err := simtestutil.GenSignedMockTx(r.rand, r.app.TxConfig(), []sdk.Msg{msg}, sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas, ChainID, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, key)
+	if err != nil {
+		return err
+	}
+	bz, err := r.app.TxConfig().TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	block, err := r.nextBlock(BlockInterval, bz)
+	if err != nil {
+		return err
+	}
+	result := block.TxResults[0]
+	if result.Code != 0 {
+		return errors.New(result.Log)
+	}
+	if res == nil {
+		return nil
+	}
+	var data sdk.TxMsgData
+	if err := proto.Unmarshal(result.Data, &data); err != nil {
+		return err
+	}
+	if len(data.MsgResponses) != 1 {
+		return fmt.Errorf("expected a single message response, got %d", len(data.MsgResponses))
+	}
+	return proto.Unmarshal(data.MsgResponses[0].Value, res)
+}
+
+// nextBlock finalizes and commits a block d after the previous one
+func (r *Runner) nextBlock(d time.Duration, txs ...[]byte) (*abci.ResponseFinalizeBlock, error) {
+	r.time = r.time.Add(d)
+	res, err := r.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             r.app.LastBlockHeight() + 1,
+		Time:               r.time,
+		Txs:                txs,
+		NextValidatorsHash: r.valSet.Hash(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("finalize block: %w", err)
+	}
+	if _, err := r.app.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return res, nil
+}
+
+// context returns a context on the last committed state
+func (r *Runner) context() sdk.Context {
+	return r.app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: ChainID,
+		Height:  r.app.LastBlockHeight(),
+		Time:    r.time,
+	})
+}
+
+func (r *Runner) expect(e Expect) error {
+	ctx := r.context()
+	var mismatches []string
+	mismatch := func(format string, args ...any) {
+		mismatches = append(mismatches, fmt.Sprintf(format, args...))
+	}
+
+	for _, name := range sortedKeys(e.Balances) {
+		addr, err := r.address(name)
+		if err != nil {
+			return err
+		}
+		want, err := sdk.ParseCoinsNormalized(e.Balances[name])
+		if err != nil {
+			return fmt.Errorf("balance of %s: %w", name, err)
+		}
+		got := r.app.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(addr))
+		if !got.Equal(want) {
+			mismatch("balance of %s: expected %q, got %q", name, want, got)
+		}
+	}
+
+	for _, name := range sortedKeys(e.Positions) {
+		addr, err := r.address(name)
+		if err != nil {
+			return err
+		}
+		want := make(map[string]string)
+		for key, amount := range e.Positions[name] {
+			market, outcome, _ := strings.Cut(key, "/")
+			marketId, err := r.market(market)
+			if err != nil {
+				return fmt.Errorf("position %s of %s: %w", key, name, err)
+			}
+			want[fmt.Sprintf("%d/%s", marketId, outcome)] = amount
+		}
+		got := make(map[string]string)
+		if err := r.app.PredictionKeeper.Positions.Walk(ctx, nil,
+			func(key collections.Triple[uint64, string, uint32], pos predictiontypes.Position) (bool, error) {
+				if key.K2() == addr && pos.Amount != nil {
+					got[fmt.Sprintf("%d/%d", key.K1(), key.K3())] = pos.Amount.String()
+				}
+				return false, nil
+			}); err != nil {
+			return err
+		}
+		if fmt.Sprint(want) != fmt.Sprint(got) {
+			mismatch("positions of %s: expected %v, got %v", name, want, got)
+		}
+	}
+
+	for _, ref := range sortedKeys(e.Orders) {
+		want := e.Orders[ref]
+		orderId, err := r.order(ref)
+		if err != nil {
+			return err
+		}
+		order, found := r.app.PredictionKeeper.GetOrder(ctx, orderId)
+		if !found {
+			mismatch("order %s: not found", ref)
+			continue
+		}
+		if status := strings.TrimPrefix(order.Status.String(), "ORDER_STATUS_"); want.Status != "" && status != want.Status {
+			mismatch("status of order %s: expected %s, got %s", ref, want.Status, status)
+		}
+		if want.Filled != "" && (order.FilledAmount == nil || order.FilledAmount.String() != want.Filled) {
+			mismatch("filled amount of order %s: expected %s, got %v", ref, want.Filled, order.FilledAmount)
+		}
+	}
+
+	for _, ref := range sortedKeys(e.Markets) {
+		want := e.Markets[ref]
+		marketId, err := r.market(ref)
+		if err != nil {
+			return err
+		}
+		market, found := r.app.PredictionKeeper.GetPredictionMarket(ctx, marketId)
+		if !found {
+			mismatch("market %s: not found", ref)
+			continue
+		}
+		if want.Status != "" && market.Status != want.Status {
+			mismatch("status of market %s: expected %s, got %s", ref, want.Status, market.Status)
+		}
+		if want.Outcome != "" {
+			outcome, found := r.app.SettlementKeeper.GetOutcome(ctx, marketId)
+			if !found {
+				outcome = "-"
+			}
+			if outcome != want.Outcome {
+				mismatch("outcome of market %s: expected %s, got %s", ref, want.Outcome, outcome)
+			}
+		}
+		if want.Bond != "" {
+			bond := "-"
+			if market.CreatorBond != nil {
+				bond = market.CreatorBond.String()
+			}
+			if bond != want.Bond {
+				mismatch("bond of market %s: expected %s, got %s", ref, want.Bond, bond)
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(e.Reputation) {
+		addr, group, err := r.scoreKey(key)
+		if err != nil {
+			return err
+		}
+		score, _ := r.app.ReputationKeeper.GetReputationScore(ctx, addr, group)
+		if want := strconv.FormatInt(e.Reputation[key], 10); score != want {
+			mismatch("reputation of %s: expected %s, got %s", key, want, score)
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return errors.New(strings.Join(mismatches, "; "))
+	}
+	return nil
+}
+
+// address returns the address of a named account
+func (r *Runner) address(name string) (string, error) {
+	key, ok := r.keys[name]
+	if !ok {
+		return "", fmt.Errorf("unknown account %s", name)
+	}
+	return sdk.AccAddress(key.PubKey().Address()).String(), nil
+}
+
+// scoreKey returns the address and group of a "name" or "name/group" key
+func (r *Runner) scoreKey(key string) (string, string, error) {
+	name, group, _ := strings.Cut(key, "/")
+	addr, err := r.address(name)
+	return addr, group, err
+}
+
+func (r *Runner) market(ref string) (uint64, error) {
+	return resolve("market", r.markets, ref)
+}
+
+func (r *Runner) order(ref string) (uint64, error) {
+	return resolve("order", r.orders, ref)
+}
+
+// resolve returns the ID of a ref, or of a numeric ID
+func resolve(kind string, refs map[string]uint64, ref string) (uint64, error) {
+	if id, ok := refs[ref]; ok {
+		return id, nil
+	}
+	id, err := strconv.ParseUint(ref, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unknown %s %s", kind, ref)
+	}
+	return id, nil
+}
+
+// parseDuration parses a Go duration such as "1h30m"
+func parseDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return d, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,209 @@
+// Package scenario runs YAML scenarios of the economic logic of the chain
+// against an in-process app.App, the regression suite of markets, orders,
+// settlement and reputation.
+//
+// A scenario funds named accounts at genesis and executes its steps in
+// order. Every transaction step is signed by its account and delivered in a
+// block of its own, so ante handlers and begin and end blockers run as on a
+// node; advance_time moves the block time forward. Expect steps assert
+// balances, positions, orders, market outcomes and reputation scores:
+//
+//	name: consensus
+//	accounts:
+//	  alice: 1000stake
+//	  bob: 1000stake
+//	steps:
+//	  - create_market: {as: alice, ref: rain, question: "Will it rain?", outcomes: ["Yes", "No"], deadline: 1h}
+//	  - advance_time: 1h
+//	  - commit: {as: alice, market: rain, vote: "Yes", nonce: alice-nonce}
+//	  - commit: {as: bob, market: rain, vote: "Yes", nonce: bob-nonce}
+//	  - reveal: {as: alice, market: rain}
+//	  - reveal: {as: bob, market: rain}
+//	  - finalize: {as: bob, market: rain}
+//	  - expect:
+//	      markets: {rain: {status: resolved, outcome: "Yes"}}
+//	      reputation: {alice: 1, bob: 1}
+//
+// Markets and orders are named by the ref given when they are
+// created, or by their numeric ID. A step with an error fails the scenario
+// unless the step fails with an error containing it. YAML 1.1 reads a bare
+// Yes or No as a boolean, so such outcomes must be quoted.
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Scenario is a sequence of steps run on a fresh chain
+type Scenario struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// GenesisTime is the time of the first block, 2025-01-01 when zero
+	GenesisTime time.Time `json:"genesis_time,omitempty"`
+	// Accounts are the coins of each named account at genesis, e.g.
+	// "1000stake,5token". Every account signing a step must be listed.
+	Accounts map[string]string `json:"accounts"`
+	// Reputation are the scores at genesis, keyed by account name, or by
+	// "name/group" for the score in a group
+	Reputation map[string]int64 `json:"reputation,omitempty"`
+	Steps      []Step           `json:"steps"`
+}
+
+// Step is a single action of a scenario; exactly one action is set
+type Step struct {
+	CreateMarket   *CreateMarket   `json:"create_market,omitempty"`
+	CreateTemplate *CreateTemplate `json:"create_template,omitempty"`
+	PostOrder      *PostOrder      `json:"post_order,omitempty"`
+	CancelOrder    *CancelOrder    `json:"cancel_order,omitempty"`
+	Commit         *Vote           `json:"commit,omitempty"`
+	Reveal         *Vote           `json:"reveal,omitempty"`
+	Finalize       *Finalize       `json:"finalize,omitempty"`
+	// AdvanceTime is a duration the block time moves forward by, in a block
+	// of its own, e.g. "1h30m"
+	AdvanceTime string  `json:"advance_time,omitempty"`
+	Expect      *Expect `json:"expect,omitempty"`
+	// Error is a substring of the error the step must fail with
+	Error string `json:"error,omitempty"`
+}
+
+// CreateMarket creates a market closing Deadline after the block time
+type CreateMarket struct {
+	As       string   `json:"as"`
+	Ref      string   `json:"ref,omitempty"`
+	Question string   `json:"question"`
+	Outcomes []string `json:"outcomes"`
+	Deadline string   `json:"deadline"`
+}
+
+// CreateTemplate creates a market template on an x/epochs identifier. Its
+// markets are named by their numeric ID.
+type CreateTemplate struct {
+	As       string   `json:"as"`
+	Question string   `json:"question"`
+	Outcomes []string `json:"outcomes"`
+	// Duration is the time each market of the template stays open
+	Duration   string `json:"duration"`
+	Epoch      string `json:"epoch"`
+	Recurrence int64  `json:"recurrence,omitempty"`
+	Bond       string `json:"bond,omitempty"`
+	RollBond   bool   `json:"roll_bond,omitempty"`
+}
+
+// PostOrder posts an order on an outcome of a market
+type PostOrder struct {
+	As      string `json:"as"`
+	Ref     string `json:"ref,omitempty"`
+	Market  string `json:"market"`
+	Outcome uint32 `json:"outcome"`
+	Side    string `json:"side"`
+	Price   string `json:"price"`
+	Amount  string `json:"amount"`
+}
+
+// CancelOrder cancels an order
+type CancelOrder struct {
+	As    string `json:"as"`
+	Order string `json:"order"`
+}
+
+// Vote commits or reveals a settlement vote. A reveal without a vote and
+// nonce reveals those of the commit of the account.
+type Vote struct {
+	As     string `json:"as"`
+	Market string `json:"market"`
+	Vote   string `json:"vote,omitempty"`
+	Nonce  string `json:"nonce,omitempty"`
+}
+
+// Finalize finalizes the outcome of a market from its revealed votes
+type Finalize struct {
+	As     string `json:"as"`
+	Market string `json:"market"`
+}
+
+// Expect asserts the state of the chain. Only the listed accounts, orders
+// and markets are checked.
+type Expect struct {
+	// Balances are the exact coins of each account, "" for none
+	Balances map[string]string `json:"balances,omitempty"`
+	// Positions are the exact positions of each account, keyed by
+	// "market/outcome"
+	Positions  map[string]map[string]string `json:"positions,omitempty"`
+	Orders     map[string]OrderState        `json:"orders,omitempty"`
+	Markets    map[string]MarketState       `json:"markets,omitempty"`
+	Reputation map[string]int64             `json:"reputation,omitempty"`
+}
+
+// OrderState is the expected state of an order; empty fields are not checked
+type OrderState struct {
+	// Status is OPEN, PARTIALLY_FILLED, FILLED or CANCELLED
+	Status string `json:"status,omitempty"`
+	Filled string `json:"filled,omitempty"`
+}
+
+// MarketState is the expected state of a market; empty fields are not checked
+type MarketState struct {
+	Status string `json:"status,omitempty"`
+	// Outcome is the finalized outcome, "-" when none was finalized
+	Outcome string `json:"outcome,omitempty"`
+	// Bond is the creator bond the market holds, "-" for none
+	Bond string `json:"bond,omitempty"`
+}
+
+// action returns the name of the action of a step
+func (s Step) action() (string, error) {
+	var names []string
+	for name, set := range map[string]bool{
+		"create_market":   s.CreateMarket != nil,
+		"create_template": s.CreateTemplate != nil,
+		"post_order":      s.PostOrder != nil,
+		"cancel_order":    s.CancelOrder != nil,
+		"commit":          s.Commit != nil,
+		"reveal":          s.Reveal != nil,
+		"finalize":        s.Finalize != nil,
+		"advance_time":    s.AdvanceTime != "",
+		"expect":          s.Expect != nil,
+	} {
+		if set {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	switch len(names) {
+	case 0:
+		return "", errors.New("step has no action")
+	case 1:
+		return names[0], nil
+	default:
+		return "", fmt.Errorf("step has several actions %v", names)
+	}
+}
+
+// Load reads a scenario from a YAML file. Unknown fields are rejected so that
+// a misspelt assertion cannot pass silently.
+func Load(path string) (Scenario, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Scenario{}, err
+	}
+	var s Scenario
+	if err := yaml.UnmarshalStrict(bz, &s); err != nil {
+		return Scenario{}, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if s.Name == "" {
+		s.Name = filepath.Base(path)
+	}
+	for i, step := range s.Steps {
+		if _, err := step.action(); err != nil {
+			return Scenario{}, fmt.Errorf("%s: step %d: %w", path, i+1, err)
+		}
+	}
+	return s, nil
+}
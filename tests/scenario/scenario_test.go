@@ -0,0 +1,56 @@
+package scenario_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"speculod/tests/scenario"
+)
+
+var scenarios = flag.String("scenarios", "testdata/*.yaml", "glob of the scenario files to run")
+
+func TestScenarios(t *testing.T) {
+	paths, err := filepath.Glob(*scenarios)
+	require.NoError(t, err)
+	require.NotEmpty(t, paths, "no scenario matches %s", *scenarios)
+	for _, path := range paths {
+		t.Run(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)), func(t *testing.T) {
+			s, err := scenario.Load(path)
+			require.NoError(t, err)
+			require.NoError(t, scenario.Run(s), s.Name)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	_, err := scenario.Load(write("typo.yaml", "steps:\n  - expect: {balance: {alice: 1stake}}\n"))
+	require.ErrorContains(t, err, "unknown field")
+	_, err = scenario.Load(write("two.yaml", "steps:\n  - advance_time: 1h\n    expect: {}\n"))
+	require.ErrorContains(t, err, "step 1: step has several actions [advance_time expect]")
+	_, err = scenario.Load(write("none.yaml", "steps:\n  - error: failed\n"))
+	require.ErrorContains(t, err, "step has no action")
+
+	s, err := scenario.Load(write("named.yaml", "accounts: {alice: 1stake}\nsteps:\n  - advance_time: 1h\n"))
+	require.NoError(t, err)
+	require.Equal(t, "named.yaml", s.Name)
+	require.Equal(t, map[string]string{"alice": "1stake"}, s.Accounts)
+
+	// An expected error fails the step when another error occurs
+	err = scenario.Run(scenario.Scenario{
+		Accounts: map[string]string{"alice": "1stake"},
+		Steps:    []scenario.Step{{Finalize: &scenario.Finalize{As: "alice", Market: "1"}, Error: "already finalized"}},
+	})
+	require.ErrorContains(t, err, `step 1 (finalize): expected error "already finalized", got`)
+}
@@ -0,0 +1,233 @@
+// Package keeper provides a test fixture wiring the prediction, settlement
+// and reputation keepers together, as in the app, with real auth, bank and
+// epochs keepers on an in-memory store. Cross-module flows such as creator
+// bonds are exercised against the real bank, and the invariants of the chain
+// modules are asserted at the end of every block.
+//
+// The x/group keeper, which needs a message router, is replaced by a
+// generated mock; tests set the expectations they rely on.
+package keeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	predictionkeeper "speculod/x/prediction/keeper"
+	predictionmodule "speculod/x/prediction/module"
+	predictiontestutil "speculod/x/prediction/testutil"
+	predictiontypes "speculod/x/prediction/types"
+	reputationkeeper "speculod/x/reputation/keeper"
+	reputationmodule "speculod/x/reputation/module"
+	reputationtypes "speculod/x/reputation/types"
+	settlementkeeper "speculod/x/settlement/keeper"
+	settlementmodule "speculod/x/settlement/module"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+// GenesisTime is the block time of the context of a new fixture
+var GenesisTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// blocker is a module taking part in the blocks of the fixture
+type blocker interface {
+	BeginBlock(context.Context) error
+	EndBlock(context.Context) error
+}
+
+// Fixture holds the keepers of the chain modules and the context they run in
+type Fixture struct {
+	Ctx          sdk.Context
+	Codec        codec.Codec
+	AddressCodec address.Codec
+	// Authority is the address of the gov module, the authority of every keeper
+	Authority string
+
+	AuthKeeper       authkeeper.AccountKeeper
+	BankKeeper       bankkeeper.BaseKeeper
+	EpochsKeeper     *epochskeeper.Keeper
+	GroupKeeper      *predictiontestutil.MockGroupKeeper
+	PredictionKeeper predictionkeeper.Keeper
+	SettlementKeeper settlementkeeper.Keeper
+	ReputationKeeper reputationkeeper.Keeper
+
+	modules []blocker
+}
+
+// NewFixture returns a fixture at height 1 and GenesisTime, with the default
+// params of every module and the default x/epochs epochs started
+func NewFixture(t testing.TB) *Fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(
+		auth.AppModuleBasic{},
+		bank.AppModuleBasic{},
+		predictionmodule.AppModule{},
+		settlementmodule.AppModule{},
+		reputationmodule.AppModule{},
+	)
+	cdc := encCfg.Codec
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	addressCodec := addresscodec.NewBech32Codec(bech32Prefix)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	authorityStr, err := addressCodec.BytesToString(authority)
+	require.NoError(t, err)
+
+	keys := storetypes.NewKVStoreKeys(
+		authtypes.StoreKey,
+		banktypes.StoreKey,
+		epochstypes.StoreKey,
+		predictiontypes.StoreKey,
+		settlementtypes.StoreKey,
+		reputationtypes.StoreKey,
+	)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil).
+		WithBlockHeader(cmtproto.Header{Height: 1, Time: GenesisTime})
+	storeService := func(name string) store.KVStoreService {
+		return runtime.NewKVStoreService(keys[name])
+	}
+
+	authKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		storeService(authtypes.StoreKey),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			minttypes.ModuleName:       {authtypes.Minter},
+			predictiontypes.ModuleName: nil,
+		},
+		addressCodec,
+		bech32Prefix,
+		authorityStr,
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc,
+		storeService(banktypes.StoreKey),
+		authKeeper,
+		map[string]bool{},
+		authorityStr,
+		log.NewNopLogger(),
+	)
+	require.NoError(t, bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	epochsKeeper := epochskeeper.NewKeeper(storeService(epochstypes.StoreKey), cdc)
+	require.NoError(t, epochsKeeper.InitGenesis(ctx, *epochstypes.DefaultGenesis()))
+	require.NoError(t, epochsKeeper.BeginBlocker(ctx))
+
+	groupKeeper := predictiontestutil.NewMockGroupKeeper(gomock.NewController(t))
+
+	predictionKeeper := predictionkeeper.NewKeeper(
+		storeService(predictiontypes.StoreKey),
+		cdc,
+		addressCodec,
+		authority,
+		bankKeeper,
+		groupKeeper,
+		&epochsKeeper,
+	)
+	require.NoError(t, predictionKeeper.Params.Set(ctx, predictiontypes.DefaultParams()))
+
+	reputationKeeper := reputationkeeper.NewKeeper(
+		storeService(reputationtypes.StoreKey),
+		cdc,
+		addressCodec,
+		authority,
+		predictionKeeper,
+	)
+	require.NoError(t, reputationKeeper.Params.Set(ctx, reputationtypes.DefaultParams()))
+
+	settlementKeeper := settlementkeeper.NewKeeper(
+		storeService(settlementtypes.StoreKey),
+		cdc,
+		addressCodec,
+		authority,
+		predictionKeeper,
+		reputationKeeper,
+	)
+	require.NoError(t, settlementKeeper.Params.Set(ctx, settlementtypes.DefaultParams()))
+
+	return &Fixture{
+		Ctx:              ctx,
+		Codec:            cdc,
+		AddressCodec:     addressCodec,
+		Authority:        authorityStr,
+		AuthKeeper:       authKeeper,
+		BankKeeper:       bankKeeper,
+		EpochsKeeper:     &epochsKeeper,
+		GroupKeeper:      groupKeeper,
+		PredictionKeeper: predictionKeeper,
+		SettlementKeeper: settlementKeeper,
+		ReputationKeeper: reputationKeeper,
+		// In the order of the begin and end blockers of the app
+		modules: []blocker{
+			predictionmodule.NewAppModule(cdc, predictionKeeper, authKeeper, bankKeeper),
+			settlementmodule.NewAppModule(cdc, settlementKeeper, authKeeper, bankKeeper, predictionKeeper),
+			reputationmodule.NewAppModule(cdc, reputationKeeper, authKeeper, bankKeeper, predictionKeeper),
+		},
+	}
+}
+
+// FundAccount mints coins to an account
+func (f *Fixture) FundAccount(addr sdk.AccAddress, amounts sdk.Coins) error {
+	return banktestutil.FundAccount(f.Ctx, f.BankKeeper, addr, amounts)
+}
+
+// Balance returns the balance of an account in a denom
+func (f *Fixture) Balance(addr sdk.AccAddress, denom string) sdk.Coin {
+	return f.BankKeeper.GetBalance(f.Ctx, addr, denom)
+}
+
+// ModuleBalance returns the balance of a module account in a denom
+func (f *Fixture) ModuleBalance(moduleName, denom string) sdk.Coin {
+	return f.Balance(authtypes.NewModuleAddress(moduleName), denom)
+}
+
+// AdvanceTime moves to the next block, d after the current one, running the
+// x/epochs begin blocker and the begin and end blockers of the chain modules,
+// then asserts the invariants of the chain modules
+func (f *Fixture) AdvanceTime(d time.Duration) error {
+	f.Ctx = f.Ctx.
+		WithBlockHeight(f.Ctx.BlockHeight() + 1).
+		WithBlockTime(f.Ctx.BlockTime().Add(d)).
+		WithEventManager(sdk.NewEventManager())
+	if err := f.EpochsKeeper.BeginBlocker(f.Ctx); err != nil {
+		return err
+	}
+	for _, m := range f.modules {
+		if err := m.BeginBlock(f.Ctx); err != nil {
+			return err
+		}
+	}
+	for _, m := range f.modules {
+		if err := m.EndBlock(f.Ctx); err != nil {
+			return err
+		}
+	}
+	if err := f.PredictionKeeper.AssertInvariants(f.Ctx); err != nil {
+		return err
+	}
+	return f.SettlementKeeper.AssertInvariants(f.Ctx)
+}
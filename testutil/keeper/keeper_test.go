@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/testutil/keeper"
+	"speculod/testutil/sample"
+	predictionkeeper "speculod/x/prediction/keeper"
+	predictiontypes "speculod/x/prediction/types"
+	settlementkeeper "speculod/x/settlement/keeper"
+	settlementtypes "speculod/x/settlement/types"
+)
+
+func TestCreatorBondLifecycle(t *testing.T) {
+	f := keeper.NewFixture(t)
+	prediction := predictionkeeper.NewMsgServerImpl(f.PredictionKeeper)
+	settlement := settlementkeeper.NewMsgServerImpl(f.SettlementKeeper)
+
+	creator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	voter := sample.AccAddress()
+	require.NoError(t, f.FundAccount(creator, sdk.NewCoins(sdk.NewInt64Coin("stake", 5_000))))
+	bond := sdk.NewInt64Coin("stake", 1_000)
+
+	_, err := prediction.CreateMarketTemplate(f.Ctx, &predictiontypes.MsgCreateMarketTemplate{
+		Creator:         creator.String(),
+		Question:        "Rain in hour {epoch}?",
+		Outcomes:        []string{"Yes", "No"},
+		Duration:        1800,
+		EpochIdentifier: "hour",
+		Bond:            &bond,
+	})
+	require.NoError(t, err)
+
+	// Creating the template escrows the bond of its first market in the module
+	require.Equal(t, int64(4_000), f.Balance(creator, "stake").Amount.Int64())
+	require.Equal(t, int64(1_000), f.ModuleBalance(predictiontypes.ModuleName, "stake").Amount.Int64())
+
+	// The end of the hour creates the market, which holds the bond
+	require.NoError(t, f.AdvanceTime(time.Hour+time.Second))
+	market, found := f.PredictionKeeper.GetPredictionMarket(f.Ctx, 0)
+	require.True(t, found)
+	require.Equal(t, "1000stake", market.CreatorBond.String())
+	require.Equal(t, int64(4_000), f.Balance(creator, "stake").Amount.Int64())
+	require.Equal(t, int64(1_000), f.ModuleBalance(predictiontypes.ModuleName, "stake").Amount.Int64())
+
+	// Settling the market resolves it and refunds the bond
+	require.NoError(t, f.AdvanceTime(30*time.Minute))
+	_, err = settlement.CommitVote(f.Ctx, &settlementtypes.MsgCommitVote{
+		Creator: voter, MarketId: 0, Commitment: settlementtypes.VoteCommitment("Yes", "voter-nonce"),
+	})
+	require.NoError(t, err)
+	_, err = settlement.RevealVote(f.Ctx, &settlementtypes.MsgRevealVote{Creator: voter, MarketId: 0, Vote: "Yes", Nonce: "voter-nonce"})
+	require.NoError(t, err)
+	_, err = settlement.FinalizeOutcome(f.Ctx, &settlementtypes.MsgFinalizeOutcome{Creator: voter, MarketId: 0})
+	require.NoError(t, err)
+
+	market, _ = f.PredictionKeeper.GetPredictionMarket(f.Ctx, 0)
+	require.Equal(t, predictiontypes.MarketStatusResolved, market.Status)
+	require.Nil(t, market.CreatorBond)
+	require.Equal(t, int64(5_000), f.Balance(creator, "stake").Amount.Int64())
+	require.True(t, f.ModuleBalance(predictiontypes.ModuleName, "stake").IsZero())
+	score, found := f.ReputationKeeper.GetReputationScore(f.Ctx, voter, "")
+	require.True(t, found)
+	require.Equal(t, "1", score)
+
+	// The invariants hold against the real bank
+	require.NoError(t, f.AdvanceTime(time.Second))
+}
+
+func TestUnfundedTemplateIsSkipped(t *testing.T) {
+	f := keeper.NewFixture(t)
+	prediction := predictionkeeper.NewMsgServerImpl(f.PredictionKeeper)
+	creator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, f.FundAccount(creator, sdk.NewCoins(sdk.NewInt64Coin("stake", 999))))
+	bond := sdk.NewInt64Coin("stake", 1_000)
+	msg := &predictiontypes.MsgCreateMarketTemplate{
+		Creator:         creator.String(),
+		Question:        "Rain today?",
+		Outcomes:        []string{"Yes", "No"},
+		Duration:        1800,
+		EpochIdentifier: "hour",
+		Bond:            &bond,
+	}
+
+	// The bank refuses the bond of the first market, so no template is created
+	_, err := prediction.CreateMarketTemplate(f.Ctx, msg)
+	require.ErrorIs(t, err, predictiontypes.ErrInsufficientFunds)
+	_, found := f.PredictionKeeper.GetMarketTemplate(f.Ctx, 0)
+	require.False(t, found)
+
+	require.NoError(t, f.FundAccount(creator, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	_, err = prediction.CreateMarketTemplate(f.Ctx, msg)
+	require.NoError(t, err)
+	require.NoError(t, f.AdvanceTime(time.Hour+time.Second))
+	_, found = f.PredictionKeeper.GetPredictionMarket(f.Ctx, 0)
+	require.True(t, found)
+
+	// The bank refuses the bond of the next market, so it is skipped and nothing moves
+	require.NoError(t, f.AdvanceTime(time.Hour))
+	_, found = f.PredictionKeeper.GetPredictionMarket(f.Ctx, 1)
+	require.False(t, found)
+	require.True(t, f.Balance(creator, "stake").IsZero())
+	require.Equal(t, int64(1_000), f.ModuleBalance(predictiontypes.ModuleName, "stake").Amount.Int64())
+	tmpl, found := f.PredictionKeeper.GetMarketTemplate(f.Ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), tmpl.MarketsCreated)
+	require.True(t, tmpl.LastFailed)
+}
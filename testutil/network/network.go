@@ -0,0 +1,70 @@
+package network
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	"speculod/app"
+)
+
+type (
+	Network = network.Network
+	Config  = network.Config
+)
+
+// New creates instance with fully configured cosmos network.
+// Accepts optional config, that will be used in place of the DefaultConfig() if provided.
+func New(t *testing.T, configs ...Config) *Network {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping test in unit-tests mode.")
+	}
+	var cfg Config
+	if len(configs) == 0 {
+		cfg = DefaultConfig()
+	} else {
+		cfg = configs[0]
+	}
+	net, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	_, err = net.WaitForHeight(1)
+	require.NoError(t, err)
+	t.Cleanup(net.Cleanup)
+	return net
+}
+
+// DefaultConfig will initialize config for the network with a single validator
+// running the full application, including the modules that are not wired
+// through app config. All other parameters are inherited from
+// cosmos-sdk/testutil/network.DefaultConfig.
+func DefaultConfig() network.Config {
+	cfg, err := network.DefaultConfigWithAppConfig(app.AppConfig())
+	if err != nil {
+		panic(err)
+	}
+	cfg.NumValidators = 1
+	cfg.BondDenom = "stake"
+	cfg.MinGasPrices = "0.000006stake"
+	cfg.GenesisState = app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}).DefaultGenesis()
+	cfg.AppConstructor = func(val network.ValidatorI) servertypes.Application {
+		return app.New(
+			val.GetCtx().Logger,
+			dbm.NewMemDB(),
+			nil,
+			true,
+			simtestutil.EmptyAppOptions{},
+			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(cfg.ChainID),
+		)
+	}
+	return cfg
+}
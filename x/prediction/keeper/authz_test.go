@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestTradingAuthorization(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2024, 6, 7, 12, 0, 0, 0, time.UTC))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	require.NoError(t, f.keeper.SetMarketGroup(ctx, types.MarketGroup{Id: "weather"}))
+	f.keeper.SetPredictionMarket(ctx, types.PredictionMarket{Id: 0, GroupId: "weather", Outcomes: []string{"Yes", "No"}, Status: types.MarketStatusOpen, Deadline: 2_000_000_000})
+	f.keeper.SetPredictionMarket(ctx, types.PredictionMarket{Id: 1, Outcomes: []string{"Yes", "No"}, Status: types.MarketStatusOpen, Deadline: 2_000_000_000})
+	f.keeper.SetPredictionMarket(ctx, types.PredictionMarket{Id: 2, Outcomes: []string{"Yes", "No"}, Status: types.MarketStatusOpen, Deadline: 2_000_000_000})
+
+	postOrder := func(marketId uint64, side, price string, amount int64) *types.MsgPostOrder {
+		coin := sdk.NewInt64Coin("stake", amount)
+		return &types.MsgPostOrder{Creator: "granter", MarketId: marketId, Side: side, Price: price, Amount: &coin}
+	}
+
+	auth := types.NewTradingAuthorization(sdk.MsgTypeURL(&types.MsgPostOrder{}), []uint64{1}, []string{"weather"}, []string{"BUY"}, math.LegacyNewDec(50))
+	require.NoError(t, auth.ValidateBasic())
+
+	// Markets outside the listed ids and groups are rejected
+	_, err := auth.Accept(ctx, postOrder(2, "BUY", "0.5", 10))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	// So are sides that were not granted
+	_, err = auth.Accept(ctx, postOrder(1, "SELL", "0.5", 10))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	// And other messages
+	_, err = auth.Accept(ctx, &types.MsgCancelOrder{Creator: "granter"})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// Markets of a group are only allowed when the order names the group, and
+	// the msg server rejects a group the market is not in
+	_, err = auth.Accept(ctx, postOrder(0, "BUY", "0.5", 10))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	wrongGroup := postOrder(2, "BUY", "0.5", 10)
+	wrongGroup.GroupId = "weather"
+	_, err = ms.PostOrder(ctx, wrongGroup)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// Each order consumes its notional from the daily budget
+	inGroup := postOrder(0, "BUY", "0.5", 60)
+	inGroup.GroupId = "weather"
+	res, err := auth.Accept(ctx, inGroup)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	auth = res.Updated.(*types.TradingAuthorization)
+	require.Equal(t, math.LegacyNewDec(30), auth.Spent)
+
+	// Prices outside (0,1) and amounts that are not positive are rejected
+	for _, price := range []string{"0", "-0.5", "1", "2"} {
+		_, err = auth.Accept(ctx, postOrder(1, "BUY", price, 10))
+		require.ErrorIs(t, err, types.ErrInvalidPrice, price)
+	}
+	negative := postOrder(1, "BUY", "0.5", 10)
+	negative.Amount.Amount = math.NewInt(-10)
+	_, err = auth.Accept(ctx, negative)
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	res, err = auth.Accept(ctx, postOrder(1, "BUY", "0.4", 50))
+	require.NoError(t, err)
+	auth = res.Updated.(*types.TradingAuthorization)
+	require.Equal(t, math.LegacyNewDec(50), auth.Spent)
+	_, err = auth.Accept(ctx, postOrder(1, "BUY", "0.1", 1))
+	require.ErrorIs(t, err, types.ErrLimitExceeded)
+
+	// The budget is reset the next day
+	res, err = auth.Accept(ctx.WithBlockTime(ctx.BlockTime().Add(24*time.Hour)), postOrder(1, "BUY", "0.1", 1))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.1"), res.Updated.(*types.TradingAuthorization).Spent)
+
+	// Sells are charged at a price of 1 whatever their limit price
+	sells := types.NewTradingAuthorization(sdk.MsgTypeURL(&types.MsgPostOrder{}), []uint64{1}, nil, nil, math.LegacyNewDec(50))
+	res, err = sells.Accept(ctx, postOrder(1, "SELL", "0.2", 30))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(30), res.Updated.(*types.TradingAuthorization).Spent)
+	_, err = res.Updated.Accept(ctx, postOrder(1, "SELL", "0.01", 21))
+	require.ErrorIs(t, err, types.ErrLimitExceeded)
+
+	// Fills must carry the terms of the order, which the msg server enforces
+	posted, err := ms.PostOrder(ctx, postOrder(1, "SELL", "0.5", 20))
+	require.NoError(t, err)
+	fill := types.NewTradingAuthorization(sdk.MsgTypeURL(&types.MsgFillOrder{}), []uint64{1}, nil, []string{"BUY"}, math.LegacyNewDec(50))
+	amount := sdk.NewInt64Coin("stake", 20)
+	fillMsg := &types.MsgFillOrder{Filler: "granter", OrderId: posted.OrderId, Amount: &amount}
+	_, err = fill.Accept(ctx, fillMsg)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	fillMsg.Expected = &types.OrderTerms{MarketId: 1, Side: "BUY", Price: "0.5"}
+	_, err = fill.Accept(ctx, fillMsg)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.FillOrder(ctx, fillMsg)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	fillMsg.Expected = &types.OrderTerms{MarketId: 1, Side: "SELL", Price: "0.40"}
+	_, err = ms.FillOrder(ctx, fillMsg)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	fillMsg.Expected = &types.OrderTerms{MarketId: 1, Side: "SELL", Price: "1.5"}
+	_, err = fill.Accept(ctx, fillMsg)
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+
+	// Fills are charged at a price of 1 too
+	fillMsg.Expected = &types.OrderTerms{MarketId: 1, Side: "SELL", Price: "0.50"}
+	res, err = fill.Accept(ctx, fillMsg)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(20), res.Updated.(*types.TradingAuthorization).Spent)
+	_, err = ms.FillOrder(ctx, fillMsg)
+	require.NoError(t, err)
+}
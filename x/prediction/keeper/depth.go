@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+// VolumeWindow is the lookback used for the rolling traded volume
+const VolumeWindow = 24 * time.Hour
+
+// isRestingOrder reports whether an order still sits on the book
+func isRestingOrder(order types.Order) bool {
+	return order.Status == types.ORDER_STATUS_OPEN || order.Status == types.ORDER_STATUS_PARTIALLY_FILLED
+}
+
+// remainingAmount returns the unfilled size of an order
+func remainingAmount(order types.Order) math.Int {
+	if order.Amount == nil {
+		return math.ZeroInt()
+	}
+	if order.FilledAmount == nil {
+		return order.Amount.Amount
+	}
+	return order.Amount.Amount.Sub(order.FilledAmount.Amount)
+}
+
+// SortBids orders bids by price DESC, then time ASC
+func SortBids(bids []types.Order) {
+	sort.SliceStable(bids, func(i, j int) bool {
+		pi, pj := parsePrice(bids[i].Price), parsePrice(bids[j].Price)
+		if !pi.Equal(pj) {
+			return pi.GT(pj)
+		}
+		return bids[i].CreatedAt < bids[j].CreatedAt
+	})
+}
+
+// SortAsks orders asks by price ASC, then time ASC
+func SortAsks(asks []types.Order) {
+	sort.SliceStable(asks, func(i, j int) bool {
+		pi, pj := parsePrice(asks[i].Price), parsePrice(asks[j].Price)
+		if !pi.Equal(pj) {
+			return pi.LT(pj)
+		}
+		return asks[i].CreatedAt < asks[j].CreatedAt
+	})
+}
+
+// GetRestingOrders returns the sorted bids and asks resting on a market outcome
+func (k Keeper) GetRestingOrders(ctx sdk.Context, marketId uint64, outcomeIndex uint32) (bids, asks []types.Order) {
+	for _, order := range k.GetRestingOrdersByMarketAndOutcome(ctx, marketId, outcomeIndex) {
+		switch order.Side {
+		case types.ORDER_SIDE_BUY:
+			bids = append(bids, order)
+		case types.ORDER_SIDE_SELL:
+			asks = append(asks, order)
+		}
+	}
+	SortBids(bids)
+	SortAsks(asks)
+	return bids, asks
+}
+
+// aggregateLevels collapses sorted orders into price levels, keeping at most
+// levels entries (0 keeps all).
+func aggregateLevels(orders []types.Order, levels uint32) []types.OrderBookEntry {
+	var entries []types.OrderBookEntry
+	var last math.LegacyDec
+	for _, order := range orders {
+		remaining := remainingAmount(order)
+		if !remaining.IsPositive() {
+			continue
+		}
+		price := parsePrice(order.Price)
+		if len(entries) > 0 && price.Equal(last) {
+			entry := &entries[len(entries)-1]
+			total := entry.TotalAmount.AddAmount(remaining)
+			entry.TotalAmount = &total
+			entry.OrderCount++
+			continue
+		}
+		if levels > 0 && uint32(len(entries)) == levels {
+			break
+		}
+		total := sdk.NewCoin(order.Amount.Denom, remaining)
+		entries = append(entries, types.OrderBookEntry{
+			Price:       price.String(),
+			TotalAmount: &total,
+			OrderCount:  1,
+		})
+		last = price
+	}
+	return entries
+}
+
+// GetDepth builds the aggregated price ladder and top-of-book statistics for a market outcome
+func (k Keeper) GetDepth(ctx sdk.Context, marketId uint64, outcomeIndex uint32, levels uint32) types.QueryDepthResponse {
+	bids, asks := k.GetRestingOrders(ctx, marketId, outcomeIndex)
+	depth := types.QueryDepthResponse{
+		MarketId:     marketId,
+		OutcomeIndex: outcomeIndex,
+		Bids:         aggregateLevels(bids, levels),
+		Asks:         aggregateLevels(asks, levels),
+	}
+
+	if len(depth.Bids) > 0 {
+		depth.BestBid = depth.Bids[0].Price
+	}
+	if len(depth.Asks) > 0 {
+		depth.BestAsk = depth.Asks[0].Price
+	}
+	if depth.BestBid != "" && depth.BestAsk != "" {
+		depth.Spread = parsePrice(depth.BestAsk).Sub(parsePrice(depth.BestBid)).String()
+	}
+
+	volume := math.ZeroInt()
+	since := ctx.BlockTime().Add(-VolumeWindow).Unix()
+	for _, trade := range k.GetTradesByMarketAndOutcome(ctx, marketId, outcomeIndex) {
+		depth.LastPrice = parsePrice(trade.Price).String()
+		if trade.Timestamp >= since && trade.Amount != nil {
+			volume = volume.Add(trade.Amount.Amount)
+		}
+	}
+	depth.Volume24H = volume.String()
+
+	return depth
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// typedEvents returns the typed events of type T emitted on ctx
+func typedEvents[T proto.Message](t *testing.T, ctx sdk.Context) []T {
+	t.Helper()
+	var out []T
+	var zero T
+	name := proto.MessageName(zero)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != name {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		out = append(out, msg.(T))
+	}
+	return out
+}
+
+func TestTypedEvents(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0)).WithEventManager(sdk.NewEventManager())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	require.NoError(t, f.keeper.SetMarketGroup(ctx, types.MarketGroup{Id: "weather", Admins: []string{"creator"}}))
+
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		GroupId:  "weather",
+		Deadline: 2_000,
+	})
+	require.NoError(t, err)
+	marketCreated := typedEvents[*types.EventMarketCreated](t, ctx)
+	require.Len(t, marketCreated, 1)
+	require.Equal(t, created.MarketId, marketCreated[0].MarketId)
+	require.Equal(t, "weather", marketCreated[0].GroupId)
+
+	post := func(creator, side, price string, amount int64) uint64 {
+		coin := sdk.NewInt64Coin("stake", amount)
+		res, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:  creator,
+			MarketId: created.MarketId,
+			Side:     side,
+			Price:    price,
+			Amount:   &coin,
+		})
+		require.NoError(t, err)
+		return res.OrderId
+	}
+
+	sellID := post("alice", "SELL", "0.5", 10)
+	buyID := post("bob", "BUY", "0.5", 4)
+	require.Len(t, typedEvents[*types.EventOrderPosted](t, ctx), 2)
+	trades := typedEvents[*types.EventTrade](t, ctx)
+	require.Len(t, trades, 1)
+	require.Equal(t, buyID, trades[0].Trade.BuyOrderId)
+	require.Equal(t, sellID, trades[0].Trade.SellOrderId)
+	require.Equal(t, "bob", trades[0].Trade.Buyer)
+
+	cancelID := post("carol", "BUY", "0.1", 3)
+	_, err = ms.CancelOrder(ctx, &types.MsgCancelOrder{Creator: "carol", OrderId: cancelID})
+	require.NoError(t, err)
+	cancelled := typedEvents[*types.EventOrderCancelled](t, ctx)
+	require.Len(t, cancelled, 1)
+	require.Equal(t, int64(3), cancelled[0].Remaining.Amount.Int64())
+
+	// Markets are closed in deadline order, only once their deadline has passed
+	later, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it snow?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 3_000,
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.CloseExpiredMarkets(ctx.WithBlockTime(time.Unix(1_999, 0))))
+	require.Empty(t, typedEvents[*types.EventMarketClosed](t, ctx))
+
+	// Closing at the deadline expires the rest of alice's order
+	closeCtx := ctx.WithBlockTime(time.Unix(2_000, 0)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.CloseExpiredMarkets(closeCtx))
+	closed := typedEvents[*types.EventMarketClosed](t, closeCtx)
+	require.Len(t, closed, 1)
+	require.Equal(t, created.MarketId, closed[0].MarketId)
+	expired := typedEvents[*types.EventOrderExpired](t, closeCtx)
+	require.Len(t, expired, 1)
+	require.Equal(t, sellID, expired[0].OrderId)
+	require.Equal(t, int64(6), expired[0].Remaining.Amount.Int64())
+
+	market, found := f.keeper.GetPredictionMarket(closeCtx, created.MarketId)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatusClosed, market.Status)
+	require.Empty(t, f.keeper.GetRestingOrdersByMarketAndOutcome(closeCtx, created.MarketId, 0))
+	market, found = f.keeper.GetPredictionMarket(closeCtx, later.MarketId)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatusOpen, market.Status)
+
+	// Closed markets no longer accept orders
+	coin := sdk.NewInt64Coin("stake", 1)
+	_, err = ms.PostOrder(closeCtx, &types.MsgPostOrder{
+		Creator:  "dave",
+		MarketId: created.MarketId,
+		Side:     "BUY",
+		Price:    "0.5",
+		Amount:   &coin,
+	})
+	require.ErrorIs(t, err, types.ErrMarketClosed)
+}
@@ -3,12 +3,81 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
 	"speculod/x/prediction/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, market := range genState.Markets {
+		if err := k.setMarket(ctx, market); err != nil {
+			return err
+		}
+	}
+	for _, order := range genState.Orders {
+		if err := k.setOrder(ctx, order); err != nil {
+			return err
+		}
+	}
+	for _, pos := range genState.Positions {
+		key := collections.Join3(pos.Position.MarketId, pos.Position.Owner, pos.OutcomeIndex)
+		if err := k.Positions.Set(ctx, key, pos.Position); err != nil {
+			return err
+		}
+		if pos.Position.Amount != nil {
+			if err := k.addFilledExposure(ctx, pos.Position.MarketId, pos.Position.Owner, pos.OutcomeIndex, pos.Position.Amount.Amount, math.LegacyOneDec()); err != nil {
+				return err
+			}
+		}
+	}
+	for _, trade := range genState.Trades {
+		if err := k.setTrade(ctx, trade); err != nil {
+			return err
+		}
+	}
+	for _, price := range genState.LastTradePrices {
+		outcome := collections.Join(price.MarketId, price.OutcomeIndex)
+		if err := k.LastTradePrices.Set(ctx, outcome, price.Price); err != nil {
+			return err
+		}
+		if price.TwapPending {
+			if err := k.TradedOutcomes.Set(ctx, outcome); err != nil {
+				return err
+			}
+		}
+	}
+	for _, record := range genState.TwapRecords {
+		if err := k.TwapRecords.Set(ctx, collections.Join3(record.MarketId, record.OutcomeIndex, record.Timestamp), record); err != nil {
+			return err
+		}
+	}
+	for _, g := range genState.Groups {
+		if err := k.Groups.Set(ctx, g.Id, g); err != nil {
+			return err
+		}
+	}
+	for _, tmpl := range genState.Templates {
+		if err := k.SetMarketTemplate(ctx, tmpl); err != nil {
+			return err
+		}
+	}
+
+	if err := k.MarketIDSeq.Set(ctx, genState.MarketIdSeq); err != nil {
+		return err
+	}
+	if err := k.OrderIDSeq.Set(ctx, genState.OrderIdSeq); err != nil {
+		return err
+	}
+	if err := k.TradeIDSeq.Set(ctx, genState.TradeIdSeq); err != nil {
+		return err
+	}
+	return k.TemplateIDSeq.Set(ctx, genState.TemplateIdSeq)
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -21,5 +90,76 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	if err := k.Markets.Walk(ctx, nil, func(_ uint64, market types.PredictionMarket) (bool, error) {
+		genesis.Markets = append(genesis.Markets, market)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Orders.Walk(ctx, nil, func(_ uint64, order types.Order) (bool, error) {
+		genesis.Orders = append(genesis.Orders, order)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Positions.Walk(ctx, nil, func(key collections.Triple[uint64, string, uint32], pos types.Position) (bool, error) {
+		genesis.Positions = append(genesis.Positions, types.GenesisPosition{OutcomeIndex: key.K3(), Position: pos})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Trades.Walk(ctx, nil, func(_ uint64, trade types.Trade) (bool, error) {
+		genesis.Trades = append(genesis.Trades, trade)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.LastTradePrices.Walk(ctx, nil, func(key collections.Pair[uint64, uint32], price string) (bool, error) {
+		pending, err := k.TradedOutcomes.Has(ctx, key)
+		if err != nil {
+			return true, err
+		}
+		genesis.LastTradePrices = append(genesis.LastTradePrices, types.GenesisLastTradePrice{
+			MarketId:     key.K1(),
+			OutcomeIndex: key.K2(),
+			Price:        price,
+			TwapPending:  pending,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.TwapRecords.Walk(ctx, nil, func(_ collections.Triple[uint64, uint32, int64], record types.TwapRecord) (bool, error) {
+		genesis.TwapRecords = append(genesis.TwapRecords, record)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Groups.Walk(ctx, nil, func(_ string, g types.MarketGroup) (bool, error) {
+		genesis.Groups = append(genesis.Groups, g)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Templates.Walk(ctx, nil, func(_ uint64, tmpl types.MarketTemplate) (bool, error) {
+		genesis.Templates = append(genesis.Templates, tmpl)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if genesis.MarketIdSeq, err = k.MarketIDSeq.Peek(ctx); err != nil {
+		return nil, err
+	}
+	if genesis.OrderIdSeq, err = k.OrderIDSeq.Peek(ctx); err != nil {
+		return nil, err
+	}
+	if genesis.TradeIdSeq, err = k.TradeIDSeq.Peek(ctx); err != nil {
+		return nil, err
+	}
+	if genesis.TemplateIdSeq, err = k.TemplateIDSeq.Peek(ctx); err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -2,10 +2,13 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
-	"speculod/x/prediction/types"
-
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
 )
 
 func TestGenesis(t *testing.T) {
@@ -22,3 +25,73 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	// Build up state through the msg server
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________"))
+	require.NoError(t, err)
+	f.epochsKeeper.setEpoch("day", 1)
+	require.NoError(t, f.keeper.SetMarketGroup(ctx, types.MarketGroup{Id: "weather", Admins: []string{creator}}))
+	bond := sdk.NewInt64Coin("stake", 1_000)
+	f.bankKeeper.balances[creator] = sdk.NewCoins(bond)
+	_, err = ms.CreateMarketTemplate(ctx, &types.MsgCreateMarketTemplate{
+		Creator: creator, Question: "Rain on day {epoch}?", Outcomes: []string{"Yes", "No"}, Duration: 60, EpochIdentifier: "day", Bond: &bond,
+	})
+	require.NoError(t, err)
+	market, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator: creator, Question: "Will it rain?", Outcomes: []string{"Yes", "No"}, Deadline: 2_000,
+	})
+	require.NoError(t, err)
+	amount := sdk.NewInt64Coin("stake", 10)
+	_, err = ms.PostOrder(ctx, &types.MsgPostOrder{
+		Creator: "bob", MarketId: market.MarketId, OutcomeIndex: 1, Side: "SELL", Price: "0.6", Amount: &amount,
+	})
+	require.NoError(t, err)
+	_, err = ms.PostOrder(ctx, &types.MsgPostOrder{
+		Creator: "alice", MarketId: market.MarketId, OutcomeIndex: 1, Side: "BUY", Price: "0.6", Amount: &amount,
+	})
+	require.NoError(t, err)
+	f.keeper.SetPosition(ctx, types.Position{MarketId: market.MarketId, Owner: "carol", Amount: &amount, IsBuy: true}, 1)
+
+	// The trade awaits the next TWAP update until it runs
+	pending, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending.LastTradePrices, 1)
+	require.True(t, pending.LastTradePrices[0].TwapPending)
+	require.NoError(t, f.keeper.UpdateTwapAccumulators(ctx.WithBlockTime(time.Unix(1_100, 0))))
+
+	exported, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Markets, 1)
+	require.Len(t, exported.Orders, 2)
+	require.Len(t, exported.Trades, 1)
+	require.Len(t, exported.Positions, 1)
+	require.Equal(t, uint32(1), exported.Positions[0].OutcomeIndex)
+	require.Len(t, exported.LastTradePrices, 1)
+	require.False(t, exported.LastTradePrices[0].TwapPending)
+	require.NotEmpty(t, exported.TwapRecords)
+	require.Len(t, exported.Groups, 1)
+	require.Len(t, exported.Templates, 1)
+	require.Equal(t, uint64(1), exported.MarketIdSeq)
+	require.Equal(t, uint64(2), exported.OrderIdSeq)
+	require.Equal(t, uint64(1), exported.TradeIdSeq)
+	require.Equal(t, uint64(1), exported.TemplateIdSeq)
+
+	// Importing into a fresh chain restores the same state and sequences
+	imported := initFixture(t)
+	require.NoError(t, imported.keeper.InitGenesis(imported.ctx, *exported))
+	reexported, err := imported.keeper.ExportGenesis(imported.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	next, err := keeper.NewMsgServerImpl(imported.keeper).CreateMarket(sdk.UnwrapSDKContext(imported.ctx).WithBlockTime(time.Unix(1_000, 0)), &types.MsgCreateMarket{
+		Creator: creator, Question: "Will it snow?", Outcomes: []string{"Yes", "No"}, Deadline: 2_000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), next.MarketId)
+}
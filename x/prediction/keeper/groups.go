@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"speculod/x/prediction/types"
+)
+
+// SetMarketGroup stores a market group by ID
+func (k Keeper) SetMarketGroup(ctx context.Context, g types.MarketGroup) error {
+	return k.Groups.Set(ctx, g.Id, g)
+}
+
+// GetMarketGroup fetches a market group by ID
+func (k Keeper) GetMarketGroup(ctx context.Context, id string) (types.MarketGroup, bool) {
+	g, err := k.Groups.Get(ctx, id)
+	if err != nil {
+		return types.MarketGroup{}, false
+	}
+	return g, true
+}
+
+// GetGroupInfo fetches the x/group group with the given ID
+func (k Keeper) GetGroupInfo(ctx context.Context, cosmosGroupId uint64) (*group.GroupInfo, error) {
+	res, err := k.groupKeeper.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: cosmosGroupId})
+	if err != nil {
+		return nil, err
+	}
+	return res.Info, nil
+}
+
+// IsGroupAdmin reports whether address is listed as an admin of the market group
+func IsGroupAdmin(g types.MarketGroup, address string) bool {
+	for _, admin := range g.Admins {
+		if admin == address {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGroupParticipant reports whether address may act on behalf of a market
+// group: a member of the linked x/group group, or one of its group policy
+// accounts. Registry admins only manage the group and are not participants
+// unless they are also members.
+func (k Keeper) IsGroupParticipant(ctx context.Context, groupId string, address string) bool {
+	g, found := k.GetMarketGroup(ctx, groupId)
+	if !found {
+		return false
+	}
+
+	policy, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: address})
+	if err == nil && policy.Info != nil && policy.Info.GroupId == g.CosmosGroupId {
+		return true
+	}
+
+	return k.isGroupMember(ctx, g.CosmosGroupId, address)
+}
+
+// isGroupMember looks address up among the members of an x/group group
+// without walking them. x/group pages the members of a group by their primary
+// key, the group id followed by the member address, so a page of one starting
+// at that key holds the member if it exists.
+func (k Keeper) isGroupMember(ctx context.Context, cosmosGroupId uint64, address string) bool {
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil || len(addr) == 0 {
+		return false
+	}
+	key := binary.BigEndian.AppendUint64(nil, cosmosGroupId)
+	key = append(key, addr...)
+	res, err := k.groupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{
+		GroupId:    cosmosGroupId,
+		Pagination: &query.PageRequest{Key: key, Limit: 1},
+	})
+	if err != nil || len(res.Members) == 0 || res.Members[0].Member == nil {
+		return false
+	}
+	member, err := k.addressCodec.StringToBytes(res.Members[0].Member.Address)
+	return err == nil && bytes.Equal(member, addr)
+}
+
+// HasMarketGroup reports whether a market group is registered
+func (k Keeper) HasMarketGroup(ctx context.Context, groupId string) bool {
+	has, err := k.Groups.Has(ctx, groupId)
+	return err == nil && has
+}
+
+// GetAllMarketGroups returns all registered market groups
+func (k Keeper) GetAllMarketGroups(ctx context.Context) []types.MarketGroup {
+	var groups []types.MarketGroup
+	_ = k.Groups.Walk(ctx, nil, func(_ string, g types.MarketGroup) (bool, error) {
+		groups = append(groups, g)
+		return false, nil
+	})
+	return groups
+}
+
+// GetGroupMembers returns a page of the members of the x/group group linked to a market group
+func (k Keeper) GetGroupMembers(ctx context.Context, groupId string, pageReq *query.PageRequest) (*group.QueryGroupMembersResponse, error) {
+	g, found := k.GetMarketGroup(ctx, groupId)
+	if !found {
+		return nil, types.ErrGroupNotFound
+	}
+	return k.groupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: g.CosmosGroupId, Pagination: pageReq})
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	module "speculod/x/prediction/module"
+	"speculod/x/prediction/types"
+)
+
+func TestMarketGroups(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	addr := func(name string) string {
+		s, err := f.addressCodec.BytesToString([]byte(name + "____________________")[:20])
+		require.NoError(t, err)
+		return s
+	}
+	admin, member, policy, outsider := addr("admin"), addr("member"), addr("policy"), addr("outsider")
+	f.groupKeeper.addGroup(7, admin, member)
+	f.groupKeeper.policies[policy] = 7
+
+	// Only the x/group admin can register its group
+	_, err := ms.RegisterGroup(ctx, &types.MsgRegisterGroup{Creator: outsider, Id: "weather", CosmosGroupId: 7})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RegisterGroup(ctx, &types.MsgRegisterGroup{Creator: admin, Id: "weather", CosmosGroupId: 8})
+	require.ErrorIs(t, err, types.ErrGroupNotFound)
+	_, err = ms.RegisterGroup(ctx, &types.MsgRegisterGroup{Creator: admin, Id: "weather", CosmosGroupId: 7, Name: "Weather"})
+	require.NoError(t, err)
+	_, err = ms.RegisterGroup(ctx, &types.MsgRegisterGroup{Creator: admin, Id: "weather", CosmosGroupId: 7})
+	require.ErrorIs(t, err, types.ErrGroupExists)
+
+	res, err := qs.Group(ctx, &types.QueryGroupRequest{Id: "weather"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), res.Group.CosmosGroupId)
+	require.Equal(t, []string{admin}, res.Group.Admins)
+	require.Equal(t, int64(1_000), res.Group.CreatedAt)
+
+	members, err := qs.GroupMembers(ctx, &types.QueryGroupMembersRequest{Id: "weather"})
+	require.NoError(t, err)
+	require.Len(t, members.Members, 1)
+	require.Equal(t, member, members.Members[0].Member.Address)
+	_, err = qs.GroupMembers(ctx, &types.QueryGroupMembersRequest{Id: "sports"})
+	require.Error(t, err)
+
+	// Updates are restricted to registry admins
+	_, err = ms.UpdateGroup(ctx, &types.MsgUpdateGroup{Creator: member, Id: "weather", Admins: []string{member}})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.UpdateGroup(ctx, &types.MsgUpdateGroup{Creator: admin, Id: "weather", Name: "Weather bets", Admins: []string{admin, member}})
+	require.NoError(t, err)
+	groups, err := qs.Groups(ctx, &types.QueryGroupsRequest{})
+	require.NoError(t, err)
+	require.Len(t, groups.Groups, 1)
+	require.Equal(t, "Weather bets", groups.Groups[0].Name)
+	require.Equal(t, []string{admin, member}, groups.Groups[0].Admins)
+
+	createMarket := func(creator, groupId string) error {
+		_, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+			Creator:  creator,
+			Question: "Will it rain?",
+			Outcomes: []string{"Yes", "No"},
+			GroupId:  groupId,
+			Deadline: 2_000,
+		})
+		return err
+	}
+	require.ErrorIs(t, createMarket(admin, "sports"), types.ErrGroupNotFound)
+	require.ErrorIs(t, createMarket(outsider, "weather"), types.ErrUnauthorized)
+	require.NoError(t, createMarket(admin, "weather"))
+	require.NoError(t, createMarket(member, "weather"))
+	require.NoError(t, createMarket(policy, "weather"))
+	// Ungrouped markets are open to anyone
+	require.NoError(t, createMarket(outsider, ""))
+
+	// Only x/group members and policies are participants, registry admins are not
+	require.True(t, f.keeper.IsGroupParticipant(ctx, "weather", member))
+	require.True(t, f.keeper.IsGroupParticipant(ctx, "weather", policy))
+	require.False(t, f.keeper.IsGroupParticipant(ctx, "weather", admin))
+	require.False(t, f.keeper.IsGroupParticipant(ctx, "weather", outsider))
+	require.False(t, f.keeper.IsGroupParticipant(ctx, "sports", member))
+	require.True(t, f.keeper.HasMarketGroup(ctx, "weather"))
+	require.False(t, f.keeper.HasMarketGroup(ctx, "sports"))
+}
+
+// groupAccountKeeper is the part of the account keeper x/group needs to
+// create groups and page their members
+type groupAccountKeeper struct {
+	group.AccountKeeper
+	codec address.Codec
+}
+
+func (k groupAccountKeeper) AddressCodec() address.Codec { return k.codec }
+
+// TestGroupParticipantsWithGroupKeeper checks the direct member lookup
+// against the member keys of the real x/group keeper
+func TestGroupParticipantsWithGroupKeeper(t *testing.T) {
+	keys := storetypes.NewKVStoreKeys(group.StoreKey, types.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil)
+	encCfg := moduletestutil.MakeTestEncodingConfig(groupmodule.AppModule{}, module.AppModule{})
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	groupKeeper := groupkeeper.NewKeeper(keys[group.StoreKey], encCfg.Codec, baseapp.NewMsgServiceRouter(),
+		groupAccountKeeper{codec: addressCodec}, group.DefaultConfig())
+	k := keeper.NewKeeper(runtime.NewKVStoreService(keys[types.StoreKey]), encCfg.Codec, addressCodec,
+		authtypes.NewModuleAddress(types.GovModuleName), newMockBankKeeper(), groupKeeper, &mockEpochsKeeper{})
+
+	addr := func(b byte) string {
+		s, err := addressCodec.BytesToString(bytes.Repeat([]byte{b}, 20))
+		require.NoError(t, err)
+		return s
+	}
+	create := func(admin string, members ...string) uint64 {
+		var requests []group.MemberRequest
+		for _, m := range members {
+			requests = append(requests, group.MemberRequest{Address: m, Weight: "1"})
+		}
+		res, err := groupKeeper.CreateGroup(ctx, &group.MsgCreateGroup{Admin: admin, Members: requests})
+		require.NoError(t, err)
+		return res.GroupId
+	}
+	members := []string{addr(2), addr(4), addr(6), addr(8)}
+	weather := create(addr(1), members...)
+	sports := create(addr(1), addr(3))
+	require.NoError(t, k.SetMarketGroup(ctx, types.MarketGroup{Id: "weather", CosmosGroupId: weather}))
+	require.NoError(t, k.SetMarketGroup(ctx, types.MarketGroup{Id: "sports", CosmosGroupId: sports}))
+
+	for _, m := range members {
+		require.True(t, k.IsGroupParticipant(ctx, "weather", m))
+		require.False(t, k.IsGroupParticipant(ctx, "sports", m))
+	}
+	// Addresses sorting before, between and after the members, and the
+	// member of the next group
+	for _, b := range []byte{1, 3, 5, 9} {
+		require.False(t, k.IsGroupParticipant(ctx, "weather", addr(b)))
+	}
+	require.True(t, k.IsGroupParticipant(ctx, "sports", addr(3)))
+	require.False(t, k.IsGroupParticipant(ctx, "weather", "not an address"))
+}
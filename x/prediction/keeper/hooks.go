@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"speculod/x/prediction/types"
+)
+
+// Hooks gets the hooks for the prediction keeper
+func (k Keeper) Hooks() types.PredictionHooks {
+	if k.hooks == nil || *k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiPredictionHooks{}
+	}
+	return *k.hooks
+}
+
+// SetHooks sets the prediction hooks. It panics if hooks were already set.
+func (k *Keeper) SetHooks(ph types.PredictionHooks) *Keeper {
+	if *k.hooks != nil {
+		panic("cannot set prediction hooks twice")
+	}
+	*k.hooks = ph
+	return k
+}
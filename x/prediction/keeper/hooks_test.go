@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// recordingHooks records every hook call it receives
+type recordingHooks struct {
+	calls []string
+	err   error
+}
+
+var _ types.PredictionHooks = &recordingHooks{}
+
+func (h *recordingHooks) AfterMarketCreated(_ context.Context, market types.PredictionMarket) error {
+	h.calls = append(h.calls, fmt.Sprintf("created %d", market.Id))
+	return h.err
+}
+
+func (h *recordingHooks) AfterOrderPosted(_ context.Context, order types.Order) error {
+	h.calls = append(h.calls, fmt.Sprintf("posted %d", order.Id))
+	return h.err
+}
+
+func (h *recordingHooks) AfterTrade(_ context.Context, trade types.Trade) error {
+	h.calls = append(h.calls, fmt.Sprintf("trade %d", trade.TradeId))
+	return h.err
+}
+
+func (h *recordingHooks) AfterMarketClosed(_ context.Context, marketId uint64) error {
+	h.calls = append(h.calls, fmt.Sprintf("closed %d", marketId))
+	return h.err
+}
+
+func (h *recordingHooks) AfterMarketResolved(_ context.Context, marketId uint64, outcome string) error {
+	h.calls = append(h.calls, fmt.Sprintf("resolved %d %s", marketId, outcome))
+	return h.err
+}
+
+func TestPredictionHooks(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+
+	first, second := &recordingHooks{}, &recordingHooks{}
+	f.keeper.SetHooks(types.NewMultiPredictionHooks(first, second))
+	require.Panics(t, func() { f.keeper.SetHooks(first) })
+
+	// Hooks are shared with copies of the keeper, like the one held by the msg server
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 2_000,
+	})
+	require.NoError(t, err)
+
+	for _, side := range []string{"SELL", "BUY"} {
+		coin := sdk.NewInt64Coin("stake", 5)
+		_, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:  "trader",
+			MarketId: created.MarketId,
+			Side:     side,
+			Price:    "0.5",
+			Amount:   &coin,
+		})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, f.keeper.CloseExpiredMarkets(ctx.WithBlockTime(time.Unix(2_000, 0))))
+	require.NoError(t, f.keeper.ResolveMarket(ctx, created.MarketId, "Yes"))
+	require.Error(t, f.keeper.ResolveMarket(ctx, created.MarketId, "Yes"))
+
+	expected := []string{"created 0", "posted 0", "posted 1", "trade 0", "closed 0", "resolved 0 Yes"}
+	require.Equal(t, expected, first.calls)
+	require.Equal(t, expected, second.calls)
+
+	market, found := f.keeper.GetPredictionMarket(ctx, created.MarketId)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatusResolved, market.Status)
+}
+
+func TestPredictionHooksError(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	hookErr := errors.New("hook failed")
+	f.keeper.SetHooks(&recordingHooks{err: hookErr})
+
+	_, err := keeper.NewMsgServerImpl(f.keeper).CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 2_000,
+	})
+	require.ErrorIs(t, err, hookErr)
+}
+
+func TestResolveOpenMarketClosesIt(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	hooks := &recordingHooks{}
+	f.keeper.SetHooks(hooks)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 2_000,
+	})
+	require.NoError(t, err)
+	coin := sdk.NewInt64Coin("stake", 5)
+	orderRes, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+		Creator:  "trader",
+		MarketId: created.MarketId,
+		Side:     "BUY",
+		Price:    "0.5",
+		Amount:   &coin,
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, f.keeper.ResolveMarket(ctx, created.MarketId, "Maybe"), types.ErrInvalidRequest)
+	require.NoError(t, f.keeper.ResolveMarket(ctx, created.MarketId, "No"))
+	require.Equal(t, []string{"created 0", "posted 0", "closed 0", "resolved 0 No"}, hooks.calls)
+
+	order, found := f.keeper.GetOrder(ctx, orderRes.OrderId)
+	require.True(t, found)
+	require.Equal(t, types.ORDER_STATUS_CANCELLED, order.Status)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"speculod/x/prediction/types"
+)
+
+// RegisterInvariants registers all prediction invariants.
+//
+// The module account cannot yet be checked for solvency against the
+// collateral of open orders and outstanding shares: orders do not escrow
+// collateral and shares are not minted in complete sets against deposits.
+// Until they are, the account holds only creator bonds, which
+// CreatorBondInvariant checks.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "creator-bonds", CreatorBondInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "order-fills", OrderFillInvariant(k))
+}
+
+// AllInvariants runs all invariants of the prediction module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			CreatorBondInvariant(k),
+			OrderFillInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// AssertInvariants returns an error describing the first broken invariant
+func (k Keeper) AssertInvariants(ctx sdk.Context) error {
+	if msg, broken := AllInvariants(k)(ctx); broken {
+		return errorsmod.Wrap(types.ErrInvariantBroken, msg)
+	}
+	return nil
+}
+
+// CreatorBondInvariant checks that the module account balance equals the
+// creator bonds held for unresolved markets and for the next market of
+// templates.
+func CreatorBondInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expected sdk.Coins
+		err := k.Markets.Walk(ctx, nil, func(_ uint64, market types.PredictionMarket) (bool, error) {
+			if market.CreatorBond != nil && market.CreatorBond.IsPositive() {
+				expected = expected.Add(*market.CreatorBond)
+			}
+			return false, nil
+		})
+		if err == nil {
+			err = k.Templates.Walk(ctx, nil, func(_ uint64, tmpl types.MarketTemplate) (bool, error) {
+				if tmpl.BondEscrowed {
+					expected = expected.Add(*tmpl.Bond)
+				}
+				return false, nil
+			})
+		}
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "creator-bonds", err.Error()), true
+		}
+
+		balance := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		broken := !balance.Equal(expected)
+		return sdk.FormatInvariant(types.ModuleName, "creator-bonds", fmt.Sprintf(
+			"\tmodule account balance: %s\n\tcreator bonds held: %s\n", balance, expected)), broken
+	}
+}
+
+// OrderFillInvariant checks that no order is filled beyond its amount
+func OrderFillInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		err := k.Orders.Walk(ctx, nil, func(id uint64, order types.Order) (bool, error) {
+			if order.FilledAmount == nil || order.Amount == nil {
+				return false, nil
+			}
+			if order.FilledAmount.Amount.GT(order.Amount.Amount) {
+				broken = true
+				msg += fmt.Sprintf("\torder %d: filled %s of %s\n", id, order.FilledAmount, order.Amount)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "order-fills", err.Error()), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, "order-fills", msg), broken
+	}
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestInvariants(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	res, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 2_000,
+	})
+	require.NoError(t, err)
+	marketId := res.MarketId
+	for _, order := range []struct {
+		creator string
+		side    string
+		amount  int64
+	}{
+		{"alice", "BUY", 60},
+		{"bob", "SELL", 40},
+	} {
+		coin := sdk.NewInt64Coin("stake", order.amount)
+		_, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:  order.creator,
+			MarketId: marketId,
+			Side:     order.side,
+			Price:    "0.5",
+			Amount:   &coin,
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.keeper.AssertInvariants(ctx))
+
+	t.Run("creator bonds", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		market, _ := f.keeper.GetPredictionMarket(ctx, marketId)
+		bond := sdk.NewInt64Coin("stake", 10)
+		market.CreatorBond = &bond
+		f.keeper.SetPredictionMarket(ctx, market)
+		_, broken := keeper.CreatorBondInvariant(f.keeper)(ctx)
+		require.True(t, broken)
+
+		f.bankKeeper.balances[types.ModuleName] = sdk.NewCoins(bond)
+		defer delete(f.bankKeeper.balances, types.ModuleName)
+		_, broken = keeper.CreatorBondInvariant(f.keeper)(ctx)
+		require.False(t, broken)
+	})
+
+	t.Run("order fills", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		order, found := f.keeper.GetOrder(ctx, 1)
+		require.True(t, found)
+		overfilled := order.Amount.AddAmount(order.Amount.Amount)
+		order.FilledAmount = &overfilled
+		require.NoError(t, f.keeper.Orders.Set(ctx, order.Id, order))
+		msg, broken := keeper.OrderFillInvariant(f.keeper)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, "order 1")
+		require.ErrorIs(t, f.keeper.AssertInvariants(ctx), types.ErrInvariantBroken)
+	})
+}
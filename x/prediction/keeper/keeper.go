@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -21,48 +23,6 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
-// PositionKeyTuple is a tuple key for position storage
-var PositionKeyTuple = collections.NewPrefix("positions")
-
-// Custom key type for positions
-type PositionKey struct {
-	MarketId     uint64
-	Owner        string
-	OutcomeIndex uint32
-}
-
-// Custom key codec for PositionKey
-type PositionKeyCodec struct{}
-
-func (PositionKeyCodec) Encode(b []byte, k PositionKey) ([]byte, error) {
-	b = sdk.Uint64ToBigEndian(k.MarketId)
-	b = append(b, []byte(k.Owner)...)
-	b = append(b, sdk.Uint64ToBigEndian(uint64(k.OutcomeIndex))...)
-	return b, nil
-}
-func (PositionKeyCodec) Decode(b []byte) (PositionKey, []byte, error) {
-	if len(b) < 8 {
-		return PositionKey{}, nil, fmt.Errorf("not enough bytes for market id")
-	}
-	marketId := sdk.BigEndianToUint64(b[:8])
-	b = b[8:]
-	// Owner is variable length, so we need a separator or fixed length. For now, assume 42 (bech32 address)
-	if len(b) < 42+8 {
-		return PositionKey{}, nil, fmt.Errorf("not enough bytes for owner+outcome")
-	}
-	owner := string(b[:42])
-	b = b[42:]
-	outcomeIndex := uint32(sdk.BigEndianToUint64(b[:8]))
-	b = b[8:]
-	return PositionKey{marketId, owner, outcomeIndex}, b, nil
-}
-func (PositionKeyCodec) Size(_ PositionKey) int { return 8 + 42 + 8 }
-
-// Helper to build composite key for positions
-func PositionCompositeKey(marketId uint64, owner string, outcomeIndex uint32) string {
-	return fmt.Sprintf("%d/%s/%d", marketId, owner, outcomeIndex)
-}
-
 // Keeper struct (add fields for market storage)
 type Keeper struct {
 	storeService corestore.KVStoreService
@@ -70,21 +30,54 @@ type Keeper struct {
 	addressCodec address.Codec
 	// Address capable of executing a MsgUpdateParams message.
 	// Typically, this should be the x/gov module account.
-	authority  []byte
-	bankKeeper types.BankKeeper
-	Schema     collections.Schema
-	Params     collections.Item[types.Params]
-
-	// Market storage
-	MarketIDSeq collections.Sequence
-	Markets     collections.Map[uint64, types.PredictionMarket]
-
-	// Order storage
-	OrderIDSeq collections.Sequence
-	Orders     collections.Map[uint64, types.Order]
-
-	// Position storage
-	Positions collections.Map[string, types.Position]
+	authority    []byte
+	bankKeeper   types.BankKeeper
+	groupKeeper  types.GroupKeeper
+	epochsKeeper types.EpochsKeeper
+	Schema       collections.Schema
+	Params       collections.Item[types.Params]
+
+	// Market storage, with open markets indexed by deadline
+	MarketIDSeq           collections.Sequence
+	Markets               collections.Map[uint64, types.PredictionMarket]
+	OpenMarketsByDeadline collections.KeySet[collections.Pair[int64, uint64]]
+
+	// Order storage, with resting orders indexed by market, outcome index and order ID
+	OrderIDSeq    collections.Sequence
+	Orders        collections.Map[uint64, types.Order]
+	RestingOrders collections.KeySet[collections.Triple[uint64, uint32, uint64]]
+
+	// Position storage, keyed by market, owner and outcome index
+	Positions collections.Map[collections.Triple[uint64, string, uint32], types.Position]
+
+	// Exposure of each account on a market outcome, keyed by market, account
+	// and outcome index, kept for the position limits
+	Exposures collections.Map[collections.Triple[uint64, string, uint32], types.Exposure]
+
+	// Trade storage, indexed by market, outcome index and trade ID
+	TradeIDSeq      collections.Sequence
+	Trades          collections.Map[uint64, types.Trade]
+	TradesByOutcome collections.KeySet[collections.Triple[uint64, uint32, uint64]]
+
+	// Price history storage. TradedOutcomes holds the outcomes traded since
+	// the TWAP accumulators were last updated.
+	LastTradePrices collections.Map[collections.Pair[uint64, uint32], string]
+	TradedOutcomes  collections.KeySet[collections.Pair[uint64, uint32]]
+	TwapRecords     collections.Map[collections.Triple[uint64, uint32, int64], types.TwapRecord]
+
+	// Group registry
+	Groups collections.Map[string, types.MarketGroup]
+
+	// Recurring market templates, with active templates indexed by creator
+	// and by epoch identifier and the epoch their next market is due
+	TemplateIDSeq   collections.Sequence
+	Templates       collections.Map[uint64, types.MarketTemplate]
+	ActiveTemplates collections.KeySet[collections.Pair[string, uint64]]
+	DueTemplates    collections.KeySet[collections.Triple[string, int64, uint64]]
+
+	// hooks is shared by all copies of the keeper, so hooks set after the
+	// module has been built are still seen by it
+	hooks *types.PredictionHooks
 }
 
 func NewKeeper(
@@ -94,6 +87,8 @@ func NewKeeper(
 
 	authority []byte,
 	bk types.BankKeeper,
+	gk types.GroupKeeper,
+	ek types.EpochsKeeper,
 
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
@@ -108,12 +103,39 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 		bankKeeper:   bk, // Can be nil for now
+		groupKeeper:  gk,
+		epochsKeeper: ek,
 		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		MarketIDSeq:  collections.NewSequence(sb, collections.NewPrefix("market_id"), "market_id_seq"),
 		Markets:      collections.NewMap(sb, collections.NewPrefix("markets"), "markets", collections.Uint64Key, codec.CollValue[types.PredictionMarket](cdc)),
 		OrderIDSeq:   collections.NewSequence(sb, collections.NewPrefix("order_id"), "order_id_seq"),
 		Orders:       collections.NewMap(sb, collections.NewPrefix("orders"), "orders", collections.Uint64Key, codec.CollValue[types.Order](cdc)),
-		Positions:    collections.NewMap(sb, PositionKeyTuple, "positions", collections.StringKey, codec.CollValue[types.Position](cdc)),
+		OpenMarketsByDeadline: collections.NewKeySet(sb, types.OpenMarketsByDeadlineKey, "open_markets_by_deadline",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		RestingOrders: collections.NewKeySet(sb, types.RestingOrdersKey, "resting_orders",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.Uint32Key, collections.Uint64Key)),
+		Positions: collections.NewMap(sb, types.PositionsKey, "positions",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Uint32Key), codec.CollValue[types.Position](cdc)),
+		Exposures: collections.NewMap(sb, collections.NewPrefix("exposures"), "exposures",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Uint32Key), codec.CollValue[types.Exposure](cdc)),
+		TradeIDSeq: collections.NewSequence(sb, collections.NewPrefix("trade_id"), "trade_id_seq"),
+		Trades:     collections.NewMap(sb, collections.NewPrefix("trades"), "trades", collections.Uint64Key, codec.CollValue[types.Trade](cdc)),
+		TradesByOutcome: collections.NewKeySet(sb, types.TradesByOutcomeKey, "trades_by_outcome",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.Uint32Key, collections.Uint64Key)),
+		LastTradePrices: collections.NewMap(sb, collections.NewPrefix("last_trade_prices"), "last_trade_prices",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.StringValue),
+		TradedOutcomes: collections.NewKeySet(sb, collections.NewPrefix("traded_outcomes"), "traded_outcomes",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key)),
+		TwapRecords: collections.NewMap(sb, collections.NewPrefix("twap_records"), "twap_records",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.Uint32Key, collections.Int64Key), codec.CollValue[types.TwapRecord](cdc)),
+		Groups:        collections.NewMap(sb, collections.NewPrefix("groups"), "groups", collections.StringKey, codec.CollValue[types.MarketGroup](cdc)),
+		TemplateIDSeq: collections.NewSequence(sb, collections.NewPrefix("template_id"), "template_id_seq"),
+		Templates:     collections.NewMap(sb, collections.NewPrefix("templates"), "templates", collections.Uint64Key, codec.CollValue[types.MarketTemplate](cdc)),
+		ActiveTemplates: collections.NewKeySet(sb, collections.NewPrefix("active_templates"), "active_templates",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		DueTemplates: collections.NewKeySet(sb, collections.NewPrefix("due_templates"), "due_templates",
+			collections.TripleKeyCodec(collections.StringKey, collections.Int64Key, collections.Uint64Key)),
+		hooks: new(types.PredictionHooks),
 	}
 
 	schema, err := sb.Build()
@@ -141,11 +163,24 @@ func (k Keeper) AppendMarket(ctx sdk.Context, creator string) uint64 {
 
 // SetPredictionMarket stores a market by ID
 func (k Keeper) SetPredictionMarket(ctx sdk.Context, market types.PredictionMarket) {
-	if err := k.Markets.Set(ctx, market.Id, market); err != nil {
+	if err := k.setMarket(ctx, market); err != nil {
 		panic(err)
 	}
 }
 
+// setMarket stores a market and indexes it by deadline while it is open.
+// Deadlines never change, so the index entry is keyed by the stored one.
+func (k Keeper) setMarket(ctx context.Context, market types.PredictionMarket) error {
+	if err := k.Markets.Set(ctx, market.Id, market); err != nil {
+		return err
+	}
+	key := collections.Join(market.Deadline, market.Id)
+	if market.Status == types.MarketStatusOpen {
+		return k.OpenMarketsByDeadline.Set(ctx, key)
+	}
+	return k.OpenMarketsByDeadline.Remove(ctx, key)
+}
+
 // GetPredictionMarket fetches a market by ID
 func (k Keeper) GetPredictionMarket(ctx sdk.Context, id uint64) (types.PredictionMarket, bool) {
 	market, err := k.Markets.Get(ctx, id)
@@ -162,8 +197,7 @@ func (k Keeper) ValidateOutcome(outcomes []string, vote string) error {
 
 // GetPosition fetches a position by market, owner, and outcome index
 func (k Keeper) GetPosition(ctx sdk.Context, marketId uint64, owner string, outcomeIndex uint32) (types.Position, bool) {
-	key := PositionCompositeKey(marketId, owner, outcomeIndex)
-	pos, err := k.Positions.Get(ctx, key)
+	pos, err := k.Positions.Get(ctx, collections.Join3(marketId, owner, outcomeIndex))
 	if err != nil {
 		return types.Position{}, false
 	}
@@ -172,35 +206,11 @@ func (k Keeper) GetPosition(ctx sdk.Context, marketId uint64, owner string, outc
 
 // SetPosition stores a position
 func (k Keeper) SetPosition(ctx sdk.Context, pos types.Position, outcomeIndex uint32) {
-	key := PositionCompositeKey(pos.MarketId, pos.Owner, outcomeIndex)
-	if err := k.Positions.Set(ctx, key, pos); err != nil {
+	if err := k.Positions.Set(ctx, collections.Join3(pos.MarketId, pos.Owner, outcomeIndex), pos); err != nil {
 		panic(err)
 	}
 }
 
-// AddToPosition adds amount to a user's position (creates if not exists)
-func (k Keeper) AddToPosition(ctx sdk.Context, marketId uint64, owner string, outcomeIndex uint32, amount *sdk.Coin) {
-	pos, found := k.GetPosition(ctx, marketId, owner, outcomeIndex)
-	if found {
-		if pos.Amount == nil {
-			c := sdk.NewCoin(amount.Denom, amount.Amount)
-			pos.Amount = &c
-		} else {
-			added := pos.Amount.Add(*amount)
-			pos.Amount = &added
-		}
-		k.SetPosition(ctx, pos, outcomeIndex)
-	} else {
-		pos = types.Position{
-			MarketId: marketId,
-			Owner:    owner,
-			Amount:   amount,
-			IsBuy:    true,
-		}
-		k.SetPosition(ctx, pos, outcomeIndex)
-	}
-}
-
 // AppendOrder increments the order ID and returns it
 func (k Keeper) AppendOrder(ctx sdk.Context) uint64 {
 	id, err := k.OrderIDSeq.Next(ctx)
@@ -212,11 +222,39 @@ func (k Keeper) AppendOrder(ctx sdk.Context) uint64 {
 
 // SetOrder stores an order by ID
 func (k Keeper) SetOrder(ctx sdk.Context, order types.Order) {
-	if err := k.Orders.Set(ctx, order.Id, order); err != nil {
+	if err := k.setOrder(ctx, order); err != nil {
 		panic(err)
 	}
 }
 
+// setOrder stores an order and indexes it by market and outcome while it
+// rests on the book. The unfilled amount of a resting order counts towards
+// its creator's exposure.
+func (k Keeper) setOrder(ctx context.Context, order types.Order) error {
+	prev, err := k.Orders.Get(ctx, order.Id)
+	switch {
+	case err == nil && isRestingOrder(prev):
+		if err := k.addRestingExposure(ctx, prev, true); err != nil {
+			return err
+		}
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if isRestingOrder(order) {
+		if err := k.addRestingExposure(ctx, order, false); err != nil {
+			return err
+		}
+	}
+	if err := k.Orders.Set(ctx, order.Id, order); err != nil {
+		return err
+	}
+	key := collections.Join3(order.MarketId, order.OutcomeIndex, order.Id)
+	if isRestingOrder(order) {
+		return k.RestingOrders.Set(ctx, key)
+	}
+	return k.RestingOrders.Remove(ctx, key)
+}
+
 // GetOrder fetches an order by ID
 func (k Keeper) GetOrder(ctx sdk.Context, id uint64) (types.Order, bool) {
 	order, err := k.Orders.Get(ctx, id)
@@ -243,23 +281,26 @@ func (k Keeper) GetAllOrders(ctx sdk.Context) []types.Order {
 	return orders
 }
 
-// GetOrdersByMarketAndOutcome returns all orders for a specific market and outcome
-func (k Keeper) GetOrdersByMarketAndOutcome(ctx sdk.Context, marketId uint64, outcomeIndex uint32) []types.Order {
+// restingOrders returns the open and partially filled orders of the markets
+// and outcomes in rng, oldest first within an outcome
+func (k Keeper) restingOrders(ctx context.Context, rng collections.Ranger[collections.Triple[uint64, uint32, uint64]]) []types.Order {
 	var orders []types.Order
-	iterator, err := k.Orders.Iterate(ctx, nil)
-	if err != nil {
-		return orders
-	}
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		order, err := iterator.Value()
-		if err == nil && order.MarketId == marketId && order.OutcomeIndex == outcomeIndex {
+	_ = k.RestingOrders.Walk(ctx, rng, func(key collections.Triple[uint64, uint32, uint64]) (bool, error) {
+		order, err := k.Orders.Get(ctx, key.K3())
+		if err == nil {
 			orders = append(orders, order)
 		}
-	}
+		return false, nil
+	})
 	return orders
 }
 
+// GetRestingOrdersByMarketAndOutcome returns the open and partially filled
+// orders of a market outcome, oldest first
+func (k Keeper) GetRestingOrdersByMarketAndOutcome(ctx sdk.Context, marketId uint64, outcomeIndex uint32) []types.Order {
+	return k.restingOrders(ctx, collections.NewSuperPrefixedTripleRange[uint64, uint32, uint64](marketId, outcomeIndex))
+}
+
 func parsePrice(priceStr string) math.LegacyDec {
 	dec, err := math.LegacyNewDecFromStr(priceStr)
 	if err != nil {
@@ -272,7 +313,7 @@ func (k Keeper) MatchOrder(ctx sdk.Context, newOrder types.Order) []types.Trade
 	var trades []types.Trade
 
 	// Get all open opposite orders for this market/outcome
-	allOrders := k.GetOrdersByMarketAndOutcome(ctx, newOrder.MarketId, newOrder.OutcomeIndex)
+	allOrders := k.GetRestingOrdersByMarketAndOutcome(ctx, newOrder.MarketId, newOrder.OutcomeIndex)
 	var candidates []types.Order
 	newOrderPrice := parsePrice(newOrder.Price)
 
@@ -332,6 +373,12 @@ func (k Keeper) MatchOrder(ctx sdk.Context, newOrder types.Order) []types.Trade
 			Amount:       &tradeCoin,
 			Timestamp:    ctx.BlockTime().Unix(),
 		}
+		if newOrder.Side == types.ORDER_SIDE_BUY {
+			trade.BuyOrderId, trade.SellOrderId = newOrder.Id, oppOrder.Id
+		} else {
+			trade.BuyOrderId, trade.SellOrderId = oppOrder.Id, newOrder.Id
+		}
+		k.SetTrade(ctx, trade)
 		trades = append(trades, trade)
 
 		// Update resting order
@@ -395,12 +442,19 @@ func (k Keeper) FillOrder(ctx sdk.Context, order types.Order, filler string, amo
 		TradeId:      tradeID,
 		MarketId:     order.MarketId,
 		OutcomeIndex: order.OutcomeIndex,
-		Buyer:        filler, // TODO: Determine buyer/seller based on order side
-		Seller:       order.Creator,
 		Price:        order.Price,
 		Amount:       &tradeCoin,
 		Timestamp:    ctx.BlockTime().Unix(),
 	}
+	// The filler takes the other side of the order
+	if order.Side == types.ORDER_SIDE_BUY {
+		trade.Buyer, trade.Seller = order.Creator, filler
+		trade.BuyOrderId = order.Id
+	} else {
+		trade.Buyer, trade.Seller = filler, order.Creator
+		trade.SellOrderId = order.Id
+	}
+	k.SetTrade(ctx, trade)
 	trades = append(trades, trade)
 
 	// Update order
@@ -419,6 +473,49 @@ func (k Keeper) FillOrder(ctx sdk.Context, order types.Order, filler string, amo
 
 // AppendTrade increments the trade ID and returns it
 func (k Keeper) AppendTrade(ctx sdk.Context) uint64 {
-	// For now, use a simple counter. In a real implementation, you'd have a trade sequence
-	return uint64(ctx.BlockHeight())
+	id, err := k.TradeIDSeq.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SetTrade stores a trade by ID and records it as the last traded price of its outcome
+func (k Keeper) SetTrade(ctx sdk.Context, trade types.Trade) {
+	if err := k.setTrade(ctx, trade); err != nil {
+		panic(err)
+	}
+	outcome := collections.Join(trade.MarketId, trade.OutcomeIndex)
+	if err := k.LastTradePrices.Set(ctx, outcome, trade.Price); err != nil {
+		panic(err)
+	}
+	if err := k.TradedOutcomes.Set(ctx, outcome); err != nil {
+		panic(err)
+	}
+}
+
+// setTrade stores a trade, indexes it by market and outcome and moves the
+// exposures of its buyer and seller
+func (k Keeper) setTrade(ctx context.Context, trade types.Trade) error {
+	if err := k.Trades.Set(ctx, trade.TradeId, trade); err != nil {
+		return err
+	}
+	if err := k.TradesByOutcome.Set(ctx, collections.Join3(trade.MarketId, trade.OutcomeIndex, trade.TradeId)); err != nil {
+		return err
+	}
+	return k.addTradeExposure(ctx, trade)
+}
+
+// GetTradesByMarketAndOutcome returns all trades for a specific market and outcome, oldest first
+func (k Keeper) GetTradesByMarketAndOutcome(ctx sdk.Context, marketId uint64, outcomeIndex uint32) []types.Trade {
+	var trades []types.Trade
+	rng := collections.NewSuperPrefixedTripleRange[uint64, uint32, uint64](marketId, outcomeIndex)
+	_ = k.TradesByOutcome.Walk(ctx, rng, func(key collections.Triple[uint64, uint32, uint64]) (bool, error) {
+		trade, err := k.Trades.Get(ctx, key.K3())
+		if err == nil {
+			trades = append(trades, trade)
+		}
+		return false, nil
+	})
+	return trades
 }
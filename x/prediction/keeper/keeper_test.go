@@ -1,7 +1,12 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/core/address"
@@ -11,7 +16,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 
 	"speculod/x/prediction/keeper"
 	module "speculod/x/prediction/module"
@@ -22,6 +30,133 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	groupKeeper  *mockGroupKeeper
+	bankKeeper   *mockBankKeeper
+	epochsKeeper *mockEpochsKeeper
+}
+
+// mockBankKeeper tracks account and module balances in memory
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	// Module balances are tracked by module name
+	if addr.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return m.balances[types.ModuleName]
+	}
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, negative := m.balances[from].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("%s has insufficient funds", from)
+	}
+	m.balances[from] = balance
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr.String(), recipientModule, amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(senderModule, recipientAddr.String(), amt)
+}
+
+// mockEpochsKeeper holds epoch infos by identifier
+type mockEpochsKeeper struct {
+	epochs map[string]epochstypes.EpochInfo
+}
+
+func (m *mockEpochsKeeper) GetEpochInfo(_ sdk.Context, identifier string) (epochstypes.EpochInfo, error) {
+	info, ok := m.epochs[identifier]
+	if !ok {
+		return epochstypes.EpochInfo{}, fmt.Errorf("epoch info not found")
+	}
+	return info, nil
+}
+
+func (m *mockEpochsKeeper) AllEpochInfos(_ sdk.Context) ([]epochstypes.EpochInfo, error) {
+	infos := make([]epochstypes.EpochInfo, 0, len(m.epochs))
+	for _, info := range m.epochs {
+		infos = append(infos, info)
+	}
+	slices.SortFunc(infos, func(a, b epochstypes.EpochInfo) int {
+		return strings.Compare(a.Identifier, b.Identifier)
+	})
+	return infos, nil
+}
+
+// setEpoch sets the current epoch number of an identifier
+func (m *mockEpochsKeeper) setEpoch(identifier string, epoch int64) {
+	m.epochs[identifier] = epochstypes.EpochInfo{Identifier: identifier, CurrentEpoch: epoch, EpochCountingStarted: true}
+}
+
+// mockGroupKeeper is an in-memory stand-in for the x/group keeper
+type mockGroupKeeper struct {
+	groups   map[uint64]*group.GroupInfo
+	members  map[uint64][]string
+	policies map[string]uint64
+}
+
+func newMockGroupKeeper() *mockGroupKeeper {
+	return &mockGroupKeeper{
+		groups:   make(map[uint64]*group.GroupInfo),
+		members:  make(map[uint64][]string),
+		policies: make(map[string]uint64),
+	}
+}
+
+// addGroup creates an x/group group with the given admin and members
+func (m *mockGroupKeeper) addGroup(id uint64, admin string, members ...string) {
+	m.groups[id] = &group.GroupInfo{Id: id, Admin: admin}
+	m.members[id] = members
+}
+
+func (m *mockGroupKeeper) GroupInfo(_ context.Context, req *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error) {
+	info, ok := m.groups[req.GroupId]
+	if !ok {
+		return nil, fmt.Errorf("group %d not found", req.GroupId)
+	}
+	return &group.QueryGroupInfoResponse{Info: info}, nil
+}
+
+// GroupMembers pages the members like x/group, in the order of their primary
+// key starting at the page key
+func (m *mockGroupKeeper) GroupMembers(_ context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	memberKey := func(address string) []byte {
+		addr := sdk.MustAccAddressFromBech32(address)
+		key := binary.BigEndian.AppendUint64(nil, req.GroupId)
+		return append(key, addr...)
+	}
+	addrs := slices.Clone(m.members[req.GroupId])
+	slices.SortFunc(addrs, func(a, b string) int { return bytes.Compare(memberKey(a), memberKey(b)) })
+	var members []*group.GroupMember
+	for _, addr := range addrs {
+		if req.Pagination != nil && bytes.Compare(memberKey(addr), req.Pagination.Key) < 0 {
+			continue
+		}
+		if req.Pagination != nil && req.Pagination.Limit > 0 && uint64(len(members)) == req.Pagination.Limit {
+			break
+		}
+		members = append(members, &group.GroupMember{GroupId: req.GroupId, Member: &group.Member{Address: addr, Weight: "1"}})
+	}
+	return &group.QueryGroupMembersResponse{Members: members, Pagination: &query.PageResponse{}}, nil
+}
+
+func (m *mockGroupKeeper) GroupPolicyInfo(_ context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	id, ok := m.policies[req.Address]
+	if !ok {
+		return nil, fmt.Errorf("group policy %s not found", req.Address)
+	}
+	return &group.QueryGroupPolicyInfoResponse{Info: &group.GroupPolicyInfo{Address: req.Address, GroupId: id}}, nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,13 +170,18 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	groupKeeper := newMockGroupKeeper()
+	bankKeeper := newMockBankKeeper()
+	epochsKeeper := &mockEpochsKeeper{epochs: make(map[string]epochstypes.EpochInfo)}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		[]byte(authority.String()),
-		nil, // pass nil for BankKeeper as a placeholder for tests
+		bankKeeper,
+		groupKeeper,
+		epochsKeeper,
 	)
 
 	// Initialize params
@@ -53,5 +193,8 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		groupKeeper:  groupKeeper,
+		bankKeeper:   bankKeeper,
+		epochsKeeper: epochsKeeper,
 	}
 }
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+// exposureKey is the key of an account's exposure on a market outcome
+func exposureKey(marketId uint64, account string, outcomeIndex uint32) collections.Triple[uint64, string, uint32] {
+	return collections.Join3(marketId, account, outcomeIndex)
+}
+
+// GetPositionLimits returns the limits in force for a market: the module
+// params with the market's overrides applied.
+func (k Keeper) GetPositionLimits(ctx sdk.Context, market types.PredictionMarket) (types.PositionLimits, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.PositionLimits{}, err
+	}
+	return params.PositionLimits.Override(market.PositionLimits), nil
+}
+
+// GetExposure returns the exposure of an account on a market outcome
+func (k Keeper) GetExposure(ctx context.Context, marketId uint64, account string, outcomeIndex uint32) (types.Exposure, error) {
+	exp, err := k.Exposures.Get(ctx, exposureKey(marketId, account, outcomeIndex))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.NewExposure(), nil
+	}
+	return exp, err
+}
+
+// updateExposure applies update to the exposure of an account on a market
+// outcome, deleting it once nothing is at stake
+func (k Keeper) updateExposure(ctx context.Context, marketId uint64, account string, outcomeIndex uint32, update func(*types.Exposure)) error {
+	exp, err := k.GetExposure(ctx, marketId, account, outcomeIndex)
+	if err != nil {
+		return err
+	}
+	update(&exp)
+	key := exposureKey(marketId, account, outcomeIndex)
+	if exp.IsZero() {
+		return k.Exposures.Remove(ctx, key)
+	}
+	return k.Exposures.Set(ctx, key, exp)
+}
+
+// addRestingExposure adds the unfilled amount of a resting order to its
+// creator's exposure, or removes it when remove is set
+func (k Keeper) addRestingExposure(ctx context.Context, order types.Order, remove bool) error {
+	price, err := types.ParsePrice(order.Price)
+	if err != nil {
+		return err
+	}
+	amount := remainingAmount(order)
+	notional := price.MulInt(amount)
+	return k.updateExposure(ctx, order.MarketId, order.Creator, order.OutcomeIndex, func(exp *types.Exposure) {
+		if remove {
+			amount, notional = amount.Neg(), notional.Neg()
+			exp.OpenOrders--
+		} else {
+			exp.OpenOrders++
+		}
+		if order.Side == types.ORDER_SIDE_BUY {
+			exp.OpenBuy = exp.OpenBuy.Add(amount)
+		} else {
+			exp.OpenSell = exp.OpenSell.Add(amount)
+		}
+		exp.OpenNotional = exp.OpenNotional.Add(notional)
+	})
+}
+
+// addFilledExposure moves an account's position on an outcome by amount,
+// positive when buying, at price
+func (k Keeper) addFilledExposure(ctx context.Context, marketId uint64, account string, outcomeIndex uint32, amount math.Int, price math.LegacyDec) error {
+	return k.updateExposure(ctx, marketId, account, outcomeIndex, func(exp *types.Exposure) {
+		exp.Position = exp.Position.Add(amount)
+		exp.Cost = exp.Cost.Add(price.MulInt(amount))
+	})
+}
+
+// addTradeExposure records a trade in the exposures of its buyer and seller
+func (k Keeper) addTradeExposure(ctx context.Context, trade types.Trade) error {
+	if trade.Amount == nil {
+		return nil
+	}
+	price := parsePrice(trade.Price)
+	if err := k.addFilledExposure(ctx, trade.MarketId, trade.Buyer, trade.OutcomeIndex, trade.Amount.Amount, price); err != nil {
+		return err
+	}
+	return k.addFilledExposure(ctx, trade.MarketId, trade.Seller, trade.OutcomeIndex, trade.Amount.Amount.Neg(), price)
+}
+
+// BuildExposures computes the exposures of every account from the stored
+// orders, trades and positions, for stores written before exposures were kept
+func (k Keeper) BuildExposures(ctx context.Context) error {
+	if err := k.Orders.Walk(ctx, nil, func(_ uint64, order types.Order) (bool, error) {
+		if !isRestingOrder(order) {
+			return false, nil
+		}
+		return false, k.addRestingExposure(ctx, order, false)
+	}); err != nil {
+		return err
+	}
+	if err := k.Trades.Walk(ctx, nil, func(_ uint64, trade types.Trade) (bool, error) {
+		return false, k.addTradeExposure(ctx, trade)
+	}); err != nil {
+		return err
+	}
+	return k.Positions.Walk(ctx, nil, func(key collections.Triple[uint64, string, uint32], pos types.Position) (bool, error) {
+		if pos.Amount == nil {
+			return false, nil
+		}
+		return false, k.addFilledExposure(ctx, key.K1(), key.K2(), key.K3(), pos.Amount.Amount, math.LegacyOneDec())
+	})
+}
+
+// CheckPositionLimits returns an error if adding amount at price on the side
+// of an outcome would take the account over the market's limits. Resting
+// orders count as filled in the direction they would move the position.
+// newOrder is set when the amount will rest as a new order of the account.
+func (k Keeper) CheckPositionLimits(ctx sdk.Context, market types.PredictionMarket, account string, outcomeIndex uint32, side types.OrderSide, amount math.Int, price math.LegacyDec, newOrder bool) error {
+	limits, err := k.GetPositionLimits(ctx, market)
+	if err != nil {
+		return err
+	}
+	if !limits.HasPositionLimit() && !limits.HasNotionalLimit() && limits.MaxOpenOrdersPerAccount == 0 {
+		return nil
+	}
+
+	var (
+		openOrders uint32
+		notional   = math.LegacyZeroDec()
+		outcome    = types.NewExposure()
+	)
+	rng := collections.NewSuperPrefixedTripleRange[uint64, string, uint32](market.Id, account)
+	if err := k.Exposures.Walk(ctx, rng, func(key collections.Triple[uint64, string, uint32], exp types.Exposure) (bool, error) {
+		openOrders += exp.OpenOrders
+		notional = notional.Add(exp.Notional())
+		if key.K3() == outcomeIndex {
+			outcome = exp
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if newOrder && limits.MaxOpenOrdersPerAccount > 0 && openOrders >= limits.MaxOpenOrdersPerAccount {
+		return errors.Wrapf(types.ErrLimitExceeded, "account %s already has %d open orders in market %d", account, openOrders, market.Id)
+	}
+
+	// The exposure on the outcome once the amount rests or is filled
+	after := outcome
+	notional = notional.Sub(outcome.Notional())
+	if newOrder {
+		after.OpenNotional = after.OpenNotional.Add(price.MulInt(amount))
+		if side == types.ORDER_SIDE_BUY {
+			after.OpenBuy = after.OpenBuy.Add(amount)
+		} else {
+			after.OpenSell = after.OpenSell.Add(amount)
+		}
+	} else {
+		signed := amount
+		if side != types.ORDER_SIDE_BUY {
+			signed = amount.Neg()
+		}
+		after.Position = after.Position.Add(signed)
+		after.Cost = after.Cost.Add(price.MulInt(signed))
+	}
+	notional = notional.Add(after.Notional())
+
+	if limits.HasPositionLimit() {
+		if long := after.Position.Add(after.OpenBuy); long.GT(limits.MaxPositionPerOutcome) {
+			return errors.Wrapf(types.ErrLimitExceeded, "long position of %s on outcome %d would reach %s, above %s", account, outcomeIndex, long, limits.MaxPositionPerOutcome)
+		}
+		if short := after.OpenSell.Sub(after.Position); short.GT(limits.MaxPositionPerOutcome) {
+			return errors.Wrapf(types.ErrLimitExceeded, "short position of %s on outcome %d would reach %s, above %s", account, outcomeIndex, short, limits.MaxPositionPerOutcome)
+		}
+	}
+	if limits.HasNotionalLimit() && notional.GT(limits.MaxNotionalPerMarket) {
+		return errors.Wrapf(types.ErrLimitExceeded, "notional of %s in market %d would exceed %s", account, market.Id, limits.MaxNotionalPerMarket)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestPositionLimits(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(types.PositionLimits{
+		MaxPositionPerOutcome:   math.NewInt(100),
+		MaxNotionalPerMarket:    math.LegacyNewDec(60),
+		MaxOpenOrdersPerAccount: 2,
+	}, types.DefaultTemplateLimits())))
+
+	createMarket := func(limits *types.PositionLimits) (uint64, error) {
+		res, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+			Creator:        "creator",
+			Question:       "Will it rain?",
+			Outcomes:       []string{"Yes", "No"},
+			Deadline:       2_000,
+			PositionLimits: limits,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.MarketId, nil
+	}
+	post := func(marketId uint64, creator string, outcome uint32, side, price string, amount int64) error {
+		coin := sdk.NewInt64Coin("stake", amount)
+		_, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:      creator,
+			MarketId:     marketId,
+			OutcomeIndex: outcome,
+			Side:         side,
+			Price:        price,
+			Amount:       &coin,
+		})
+		return err
+	}
+
+	marketId, err := createMarket(nil)
+	require.NoError(t, err)
+
+	// Position per outcome
+	require.NoError(t, post(marketId, "alice", 0, "BUY", "0.1", 60))
+	require.ErrorIs(t, post(marketId, "alice", 0, "BUY", "0.1", 41), types.ErrLimitExceeded)
+	require.NoError(t, post(marketId, "alice", 0, "BUY", "0.1", 40))
+
+	// Open orders per account
+	require.ErrorIs(t, post(marketId, "alice", 1, "BUY", "0.1", 1), types.ErrLimitExceeded)
+
+	// Notional per market: 0.5 * 100 + 0.25 * 40 = 60
+	require.NoError(t, post(marketId, "bob", 0, "SELL", "0.5", 100))
+	require.ErrorIs(t, post(marketId, "bob", 1, "SELL", "0.3", 40), types.ErrLimitExceeded)
+	require.NoError(t, post(marketId, "bob", 1, "SELL", "0.25", 40))
+
+	// Fillers are held to the same limits, counting their resting orders
+	require.NoError(t, post(marketId, "dave", 0, "BUY", "0.1", 20))
+	fill := func(filler string, amount int64) error {
+		coin := sdk.NewInt64Coin("stake", amount)
+		_, err := ms.FillOrder(ctx, &types.MsgFillOrder{Filler: filler, OrderId: 2, Amount: &coin})
+		return err
+	}
+	require.ErrorIs(t, fill("dave", 81), types.ErrLimitExceeded)
+	require.NoError(t, fill("dave", 80))
+
+	// Markets can only tighten the module limits on creation
+	_, err = createMarket(&types.PositionLimits{MaxPositionPerOutcome: math.NewInt(101)})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	tight, err := createMarket(&types.PositionLimits{MaxPositionPerOutcome: math.NewInt(10)})
+	require.NoError(t, err)
+	require.ErrorIs(t, post(tight, "alice", 0, "BUY", "0.1", 11), types.ErrLimitExceeded)
+
+	// Governance can tighten the limits of a single market but not loosen them
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.SetMarketLimits(ctx, &types.MsgSetMarketLimits{
+		Authority:      authority,
+		MarketId:       tight,
+		PositionLimits: &types.PositionLimits{MaxPositionPerOutcome: math.NewInt(1_000)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = ms.SetMarketLimits(ctx, &types.MsgSetMarketLimits{
+		Authority:      authority,
+		MarketId:       tight,
+		PositionLimits: &types.PositionLimits{MaxPositionPerOutcome: math.NewInt(5)},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, post(tight, "alice", 0, "BUY", "0.1", 6), types.ErrLimitExceeded)
+	require.NoError(t, post(tight, "alice", 0, "BUY", "0.1", 5))
+	_, err = ms.SetMarketLimits(ctx, &types.MsgSetMarketLimits{Authority: "invalid", MarketId: tight})
+	require.Error(t, err)
+
+	// Selling back a position frees the capacity it used, once it fills
+	netted, err := createMarket(nil)
+	require.NoError(t, err)
+	require.NoError(t, post(netted, "carol", 0, "BUY", "0.2", 100))
+	require.NoError(t, post(netted, "frank", 0, "SELL", "0.2", 100))
+	require.ErrorIs(t, post(netted, "carol", 0, "BUY", "0.2", 1), types.ErrLimitExceeded)
+	require.ErrorIs(t, post(netted, "frank", 0, "SELL", "0.2", 1), types.ErrLimitExceeded)
+	require.NoError(t, post(netted, "carol", 0, "SELL", "0.3", 50))
+	require.ErrorIs(t, post(netted, "carol", 0, "BUY", "0.2", 1), types.ErrLimitExceeded)
+	require.NoError(t, post(netted, "gina", 0, "BUY", "0.3", 50))
+	require.NoError(t, post(netted, "carol", 0, "BUY", "0.2", 50))
+
+	exposure, err := f.keeper.GetExposure(ctx, netted, "carol", 0)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), exposure.Position)
+	require.Equal(t, math.LegacyNewDec(5), exposure.Cost)
+	require.Equal(t, math.NewInt(50), exposure.OpenBuy)
+	require.Equal(t, uint32(1), exposure.OpenOrders)
+
+	// Prices lie strictly between 0 and 1
+	for _, price := range []string{"", "abc", "0", "-0.2", "1", "1.5"} {
+		require.ErrorIs(t, post(marketId, "erin", 1, "SELL", price, 10), types.ErrInvalidPrice, price)
+	}
+}
+
+func TestBuildExposures(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	res, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 2_000,
+	})
+	require.NoError(t, err)
+	for _, msg := range []types.MsgPostOrder{
+		{Creator: "alice", OutcomeIndex: 0, Side: "BUY", Price: "0.4"},
+		{Creator: "bob", OutcomeIndex: 0, Side: "SELL", Price: "0.3"},
+		{Creator: "bob", OutcomeIndex: 1, Side: "SELL", Price: "0.6"},
+	} {
+		amount := sdk.NewInt64Coin("stake", 10)
+		msg.MarketId, msg.Amount = res.MarketId, &amount
+		_, err := ms.PostOrder(ctx, &msg)
+		require.NoError(t, err)
+	}
+	collect := func() map[string]types.Exposure {
+		exposures := map[string]types.Exposure{}
+		require.NoError(t, f.keeper.Exposures.Walk(ctx, nil, func(key collections.Triple[uint64, string, uint32], exp types.Exposure) (bool, error) {
+			exposures[fmt.Sprintf("%d/%s/%d", key.K1(), key.K2(), key.K3())] = exp
+			return false, nil
+		}))
+		return exposures
+	}
+	want := collect()
+	require.Len(t, want, 3)
+
+	// Stored positions count as bought at a price of 1
+	held := sdk.NewInt64Coin("stake", 5)
+	f.keeper.SetPosition(ctx, types.Position{MarketId: res.MarketId, Owner: "carol", Amount: &held, IsBuy: true}, 1)
+	carol := types.NewExposure()
+	carol.Position, carol.Cost = held.Amount, math.LegacyNewDec(5)
+	want[fmt.Sprintf("%d/carol/1", res.MarketId)] = carol
+
+	require.NoError(t, f.keeper.Exposures.Clear(ctx, nil))
+	require.NoError(t, f.keeper.BuildExposures(ctx))
+	require.Equal(t, want, collect())
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+// CloseExpiredMarkets closes every open market whose deadline has passed and
+// expires the orders still resting on it.
+func (k Keeper) CloseExpiredMarkets(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	// Collect first so the store is not written while being iterated
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.Join(now+1, uint64(0)))
+	iter, err := k.OpenMarketsByDeadline.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	expired, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		market, err := k.Markets.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.closeMarket(sdkCtx, market); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closeMarket marks a market closed, expires its resting orders and runs the
+// AfterMarketClosed hook
+func (k Keeper) closeMarket(ctx sdk.Context, market types.PredictionMarket) error {
+	market.Status = types.MarketStatusClosed
+	k.SetPredictionMarket(ctx, market)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketClosed{
+		MarketId: market.Id,
+		ClosedAt: ctx.BlockTime().Unix(),
+	}); err != nil {
+		return err
+	}
+
+	if err := k.expireMarketOrders(ctx, market.Id); err != nil {
+		return err
+	}
+	return k.Hooks().AfterMarketClosed(ctx, market.Id)
+}
+
+// ResolveMarket records that a market's outcome has been finalized, refunds
+// the creator bond and runs the AfterMarketResolved hook. Markets resolved
+// before their deadline are closed first. It is called by the settlement module.
+func (k Keeper) ResolveMarket(ctx sdk.Context, marketId uint64, outcome string) error {
+	market, found := k.GetPredictionMarket(ctx, marketId)
+	if !found {
+		return errors.Wrapf(types.ErrMarketNotFound, "market %d not found", marketId)
+	}
+	if market.Status == types.MarketStatusResolved {
+		return errors.Wrapf(types.ErrInvalidRequest, "market %d is already resolved", marketId)
+	}
+	if err := types.ValidateOutcome(market.Outcomes, outcome); err != nil {
+		return err
+	}
+
+	if market.Status == types.MarketStatusOpen {
+		if err := k.closeMarket(ctx, market); err != nil {
+			return err
+		}
+	}
+	market.Status = types.MarketStatusResolved
+	if err := k.refundCreatorBond(ctx, &market); err != nil {
+		return err
+	}
+	k.SetPredictionMarket(ctx, market)
+	return k.Hooks().AfterMarketResolved(ctx, marketId, outcome)
+}
+
+// expireMarketOrders cancels all resting orders of a market
+func (k Keeper) expireMarketOrders(ctx sdk.Context, marketId uint64) error {
+	for _, order := range k.restingOrders(ctx, collections.NewPrefixedTripleRange[uint64, uint32, uint64](marketId)) {
+		order.Status = types.ORDER_STATUS_CANCELLED
+		k.SetOrder(ctx, order)
+
+		remaining := sdk.NewCoin(order.Amount.Denom, remainingAmount(order))
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderExpired{
+			OrderId:      order.Id,
+			MarketId:     order.MarketId,
+			OutcomeIndex: order.OutcomeIndex,
+			Creator:      order.Creator,
+			Remaining:    remaining,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "speculod/x/prediction/migrations/v2"
+	"speculod/x/prediction/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc); err != nil {
+		return err
+	}
+	params, err := m.keeper.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		params = types.DefaultParams()
+	} else if err != nil {
+		return err
+	}
+	params.TemplateLimits = types.DefaultTemplateLimits()
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+	return m.keeper.BuildExposures(ctx)
+}
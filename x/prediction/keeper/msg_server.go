@@ -26,8 +26,8 @@ func (k msgServer) CreateMarket(goCtx context.Context, msg *types.MsgCreateMarke
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Validation
-	if len(msg.Outcomes) < 2 {
-		return nil, errors.Wrap(types.ErrInvalidRequest, "at least two outcomes required")
+	if err := validateOutcomes(msg.Outcomes); err != nil {
+		return nil, err
 	}
 	if msg.Question == "" {
 		return nil, errors.Wrap(types.ErrInvalidRequest, "question cannot be empty")
@@ -35,38 +35,91 @@ func (k msgServer) CreateMarket(goCtx context.Context, msg *types.MsgCreateMarke
 	if msg.Deadline <= ctx.BlockTime().Unix() {
 		return nil, errors.Wrap(types.ErrInvalidRequest, "deadline must be in the future")
 	}
-	// Check for unique, non-empty outcomes
-	outcomeSet := make(map[string]struct{})
-	for _, o := range msg.Outcomes {
-		if o == "" {
-			return nil, errors.Wrap(types.ErrInvalidRequest, "outcome cannot be empty")
+	if msg.PositionLimits != nil {
+		if err := msg.PositionLimits.Validate(); err != nil {
+			return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
 		}
-		if _, exists := outcomeSet[o]; exists {
-			return nil, errors.Wrap(types.ErrInvalidRequest, "duplicate outcome")
+		params, err := k.Keeper.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !msg.PositionLimits.IsTighterThan(params.PositionLimits) {
+			return nil, errors.Wrap(types.ErrInvalidRequest, "market position limits cannot exceed the module limits")
 		}
-		outcomeSet[o] = struct{}{}
+	}
+	if err := k.checkGroupCreator(ctx, msg.GroupId, msg.Creator); err != nil {
+		return nil, err
 	}
 
 	// Assign ID and store
 	marketID := k.Keeper.AppendMarket(ctx, msg.Creator)
 	market := types.PredictionMarket{
-		Id:        marketID,
-		Question:  msg.Question,
-		Outcomes:  msg.Outcomes,
-		GroupId:   msg.GroupId,
-		Deadline:  msg.Deadline,
-		Status:    "open",
-		Creator:   msg.Creator,
-		CreatedAt: ctx.BlockTime().Unix(),
+		Id:             marketID,
+		Question:       msg.Question,
+		Outcomes:       msg.Outcomes,
+		GroupId:        msg.GroupId,
+		Deadline:       msg.Deadline,
+		Status:         types.MarketStatusOpen,
+		Creator:        msg.Creator,
+		CreatedAt:      ctx.BlockTime().Unix(),
+		PositionLimits: msg.PositionLimits,
 	}
 	k.Keeper.SetPredictionMarket(ctx, market)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketCreated{
+		MarketId: marketID,
+		Creator:  msg.Creator,
+		Question: msg.Question,
+		Outcomes: msg.Outcomes,
+		GroupId:  msg.GroupId,
+		Deadline: msg.Deadline,
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterMarketCreated(ctx, market); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateMarketResponse{
 		MarketId: marketID,
-		Status:   "open",
+		Status:   types.MarketStatusOpen,
 	}, nil
 }
 
+// validateOutcomes checks that a market has at least two unique, non-empty outcomes
+func validateOutcomes(outcomes []string) error {
+	if len(outcomes) < 2 {
+		return errors.Wrap(types.ErrInvalidRequest, "at least two outcomes required")
+	}
+	outcomeSet := make(map[string]struct{})
+	for _, o := range outcomes {
+		if o == "" {
+			return errors.Wrap(types.ErrInvalidRequest, "outcome cannot be empty")
+		}
+		if _, exists := outcomeSet[o]; exists {
+			return errors.Wrap(types.ErrInvalidRequest, "duplicate outcome")
+		}
+		outcomeSet[o] = struct{}{}
+	}
+	return nil
+}
+
+// checkGroupCreator ensures grouped markets are only created by the admins
+// or participants of a registered group
+func (k Keeper) checkGroupCreator(ctx context.Context, groupId, creator string) error {
+	if groupId == "" {
+		return nil
+	}
+	g, found := k.GetMarketGroup(ctx, groupId)
+	if !found {
+		return errors.Wrapf(types.ErrGroupNotFound, "group %s", groupId)
+	}
+	if !IsGroupAdmin(g, creator) && !k.IsGroupParticipant(ctx, groupId, creator) {
+		return errors.Wrapf(types.ErrUnauthorized, "%s is not a participant of group %s", creator, groupId)
+	}
+	return nil
+}
+
 // PostOrder handles posting a new order to the order book
 func (k msgServer) PostOrder(goCtx context.Context, msg *types.MsgPostOrder) (*types.MsgPostOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -76,31 +129,35 @@ func (k msgServer) PostOrder(goCtx context.Context, msg *types.MsgPostOrder) (*t
 	if !found {
 		return nil, errors.Wrapf(types.ErrMarketNotFound, "market %d not found", msg.MarketId)
 	}
+	if market.Status != types.MarketStatusOpen {
+		return nil, errors.Wrapf(types.ErrMarketClosed, "market %d is %s", msg.MarketId, market.Status)
+	}
 
 	// Validate outcome index
 	if msg.OutcomeIndex >= uint32(len(market.Outcomes)) {
 		return nil, errors.Wrapf(types.ErrInvalidOutcome, "outcome index %d out of range", msg.OutcomeIndex)
 	}
 
+	if msg.GroupId != "" && msg.GroupId != market.GroupId {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "market %d is not in group %s", msg.MarketId, msg.GroupId)
+	}
+
 	// Validate side
 	if msg.Side != "BUY" && msg.Side != "SELL" {
 		return nil, errors.Wrap(types.ErrInvalidRequest, "side must be BUY or SELL")
 	}
 
 	// Validate price
-	if msg.Price == "" {
-		return nil, errors.Wrap(types.ErrInvalidRequest, "price cannot be empty")
+	price, err := types.ParsePrice(msg.Price)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate amount
-	if msg.Amount == nil || msg.Amount.Amount.IsZero() {
-		return nil, errors.Wrap(types.ErrInvalidAmount, "amount cannot be zero")
+	if msg.Amount == nil || !msg.Amount.Amount.IsPositive() {
+		return nil, errors.Wrap(types.ErrInvalidAmount, "amount must be positive")
 	}
 
-	// Create order
-	orderID := k.Keeper.AppendOrder(ctx)
-	zeroCoin := sdk.NewCoin(msg.Amount.Denom, math.NewInt(0))
-
 	// Convert side string to enum
 	var side types.OrderSide
 	if msg.Side == "BUY" {
@@ -111,6 +168,15 @@ func (k msgServer) PostOrder(goCtx context.Context, msg *types.MsgPostOrder) (*t
 		return nil, errors.Wrap(types.ErrInvalidRequest, "side must be BUY or SELL")
 	}
 
+	// Enforce the account's position limits
+	if err := k.Keeper.CheckPositionLimits(ctx, market, msg.Creator, msg.OutcomeIndex, side, msg.Amount.Amount, price, true); err != nil {
+		return nil, err
+	}
+
+	// Create order
+	orderID := k.Keeper.AppendOrder(ctx)
+	zeroCoin := sdk.NewCoin(msg.Amount.Denom, math.NewInt(0))
+
 	order := types.Order{
 		Id:           orderID,
 		MarketId:     msg.MarketId,
@@ -126,7 +192,16 @@ func (k msgServer) PostOrder(goCtx context.Context, msg *types.MsgPostOrder) (*t
 
 	// Store the order and attempt automatic matching
 	k.Keeper.SetOrder(ctx, order)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderPosted{Order: order}); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterOrderPosted(ctx, order); err != nil {
+		return nil, err
+	}
 	trades := k.Keeper.MatchOrder(ctx, order)
+	if err := k.afterTrades(ctx, trades); err != nil {
+		return nil, err
+	}
 
 	// Convert trades to pointers for response
 	var tradePtrs []*types.Trade
@@ -165,10 +240,17 @@ func (k msgServer) CancelOrder(goCtx context.Context, msg *types.MsgCancelOrder)
 	order.Status = types.ORDER_STATUS_CANCELLED
 	k.Keeper.SetOrder(ctx, order)
 
-	// Refund unfilled amount if any
-	if order.FilledAmount.Amount.LT(order.Amount.Amount) {
-		// TODO: Implement refund logic
-		_ = order.Amount.Sub(*order.FilledAmount)
+	// TODO: Refund the unfilled amount once orders escrow funds
+	remaining := order.Amount.Sub(*order.FilledAmount)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderCancelled{
+		OrderId:      order.Id,
+		MarketId:     order.MarketId,
+		OutcomeIndex: order.OutcomeIndex,
+		Creator:      order.Creator,
+		Remaining:    remaining,
+	}); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgCancelOrderResponse{
@@ -190,10 +272,23 @@ func (k msgServer) FillOrder(goCtx context.Context, msg *types.MsgFillOrder) (*t
 	if order.Status != types.ORDER_STATUS_OPEN && order.Status != types.ORDER_STATUS_PARTIALLY_FILLED {
 		return nil, fmt.Errorf("order cannot be filled")
 	}
+	market, found := k.Keeper.GetPredictionMarket(ctx, order.MarketId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrMarketNotFound, "market %d not found", order.MarketId)
+	}
+	if market.Status != types.MarketStatusOpen {
+		return nil, errors.Wrapf(types.ErrMarketClosed, "market %d is %s", order.MarketId, market.Status)
+	}
+
+	if msg.Expected != nil {
+		if err := checkOrderTerms(*msg.Expected, order, market); err != nil {
+			return nil, err
+		}
+	}
 
 	// Validate fill amount
-	if msg.Amount == nil || msg.Amount.Amount.IsZero() {
-		return nil, fmt.Errorf("amount cannot be zero")
+	if msg.Amount == nil || !msg.Amount.Amount.IsPositive() {
+		return nil, fmt.Errorf("amount must be positive")
 	}
 
 	// Check if fill amount is valid
@@ -202,11 +297,58 @@ func (k msgServer) FillOrder(goCtx context.Context, msg *types.MsgFillOrder) (*t
 		return nil, fmt.Errorf("fill amount exceeds remaining amount")
 	}
 
+	// Enforce the filler's position limits on the other side of the order
+	price, err := types.ParsePrice(order.Price)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.CheckPositionLimits(ctx, market, msg.Filler, order.OutcomeIndex, order.Side.Opposite(), msg.Amount.Amount, price, false); err != nil {
+		return nil, err
+	}
+
 	// Execute the fill
-	_ = k.Keeper.FillOrder(ctx, order, msg.Filler, msg.Amount)
+	trades := k.Keeper.FillOrder(ctx, order, msg.Filler, msg.Amount)
+	if err := k.afterTrades(ctx, trades); err != nil {
+		return nil, err
+	}
+
+	tradePtrs := []*types.Trade{}
+	for i := range trades {
+		tradePtrs = append(tradePtrs, &trades[i])
+	}
 
 	return &types.MsgFillOrderResponse{
 		Status: "filled",
-		Trades: []*types.Trade{}, // Empty slice for now
+		Trades: tradePtrs,
 	}, nil
 }
+
+// afterTrades emits an EventTrade and runs the AfterTrade hook for each executed trade
+func (k msgServer) afterTrades(ctx sdk.Context, trades []types.Trade) error {
+	for _, trade := range trades {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTrade{Trade: trade}); err != nil {
+			return err
+		}
+		if err := k.Hooks().AfterTrade(ctx, trade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkOrderTerms returns an error if an order of market does not match the
+// terms a filler expects
+func checkOrderTerms(terms types.OrderTerms, order types.Order, market types.PredictionMarket) error {
+	side := "SELL"
+	if order.Side == types.ORDER_SIDE_BUY {
+		side = "BUY"
+	}
+	price, err := math.LegacyNewDecFromStr(terms.Price)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidRequest, "invalid price %s", terms.Price)
+	}
+	if terms.MarketId != order.MarketId || terms.GroupId != market.GroupId || terms.Side != side || !price.Equal(parsePrice(order.Price)) {
+		return errors.Wrapf(types.ErrInvalidRequest, "order %d does not match the expected terms", order.Id)
+	}
+	return nil
+}
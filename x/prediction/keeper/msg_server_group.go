@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+// RegisterGroup links a new market group to an x/group group administered by the signer
+func (k msgServer) RegisterGroup(goCtx context.Context, msg *types.MsgRegisterGroup) (*types.MsgRegisterGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Id == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "group id cannot be empty")
+	}
+	if _, found := k.GetMarketGroup(ctx, msg.Id); found {
+		return nil, errorsmod.Wrapf(types.ErrGroupExists, "group %s", msg.Id)
+	}
+
+	info, err := k.GetGroupInfo(ctx, msg.CosmosGroupId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrGroupNotFound, "x/group group %d: %s", msg.CosmosGroupId, err)
+	}
+	if info.Admin != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only the admin of x/group group %d can register it", msg.CosmosGroupId)
+	}
+
+	admins := msg.Admins
+	if len(admins) == 0 {
+		admins = []string{msg.Creator}
+	}
+	if err := k.validateAdmins(admins); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetMarketGroup(ctx, types.MarketGroup{
+		Id:            msg.Id,
+		CosmosGroupId: msg.CosmosGroupId,
+		Name:          msg.Name,
+		Description:   msg.Description,
+		Admins:        admins,
+		Creator:       msg.Creator,
+		CreatedAt:     ctx.BlockTime().Unix(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterGroupResponse{}, nil
+}
+
+// UpdateGroup replaces the metadata and admins of a market group
+func (k msgServer) UpdateGroup(goCtx context.Context, msg *types.MsgUpdateGroup) (*types.MsgUpdateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, found := k.GetMarketGroup(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrGroupNotFound, "group %s", msg.Id)
+	}
+	if !IsGroupAdmin(g, msg.Creator) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not an admin of group %s", msg.Creator, msg.Id)
+	}
+	if len(msg.Admins) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "a group needs at least one admin")
+	}
+	if err := k.validateAdmins(msg.Admins); err != nil {
+		return nil, err
+	}
+
+	g.Name = msg.Name
+	g.Description = msg.Description
+	g.Admins = msg.Admins
+	if err := k.SetMarketGroup(ctx, g); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupResponse{}, nil
+}
+
+// validateAdmins checks that every admin is a valid, unique address
+func (k msgServer) validateAdmins(admins []string) error {
+	seen := make(map[string]struct{}, len(admins))
+	for _, admin := range admins {
+		if _, err := k.addressCodec.StringToBytes(admin); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidRequest, "invalid admin address %s: %s", admin, err)
+		}
+		if _, ok := seen[admin]; ok {
+			return errorsmod.Wrapf(types.ErrInvalidRequest, "duplicate admin %s", admin)
+		}
+		seen[admin] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+// CreateMarketTemplate registers a market created again every recurrence epochs
+func (k msgServer) CreateMarketTemplate(goCtx context.Context, msg *types.MsgCreateMarketTemplate) (*types.MsgCreateMarketTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+	if msg.Question == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "question cannot be empty")
+	}
+	if err := validateOutcomes(msg.Outcomes); err != nil {
+		return nil, err
+	}
+	if msg.Duration <= 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "duration must be positive")
+	}
+	if msg.Recurrence < 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "recurrence cannot be negative")
+	}
+	recurrence := msg.Recurrence
+	if recurrence == 0 {
+		recurrence = 1
+	}
+	var bond *sdk.Coin
+	if msg.Bond != nil && !msg.Bond.IsZero() {
+		if err := msg.Bond.Validate(); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidAmount, err.Error())
+		}
+		bond = msg.Bond
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.TemplateLimits.CoversMinBond(bond) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "bond must be at least %s", params.TemplateLimits.MinBond)
+	}
+	if err := k.checkGroupCreator(ctx, msg.GroupId, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.CheckActiveTemplates(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	epoch, err := k.currentEpoch(ctx, msg.EpochIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.TemplateIDSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := types.MarketTemplate{
+		Id:              id,
+		Creator:         msg.Creator,
+		Question:        msg.Question,
+		Outcomes:        msg.Outcomes,
+		GroupId:         msg.GroupId,
+		Duration:        msg.Duration,
+		EpochIdentifier: msg.EpochIdentifier,
+		Recurrence:      recurrence,
+		Bond:            bond,
+		RollBond:        msg.RollBond,
+		Active:          true,
+		LastEpoch:       epoch,
+		CreatedAt:       ctx.BlockTime().Unix(),
+	}
+	if err := k.escrowTemplateBond(ctx, &tmpl); err != nil {
+		return nil, err
+	}
+	if err := k.SetMarketTemplate(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMarketTemplateCreated{
+		TemplateId:      id,
+		Creator:         msg.Creator,
+		EpochIdentifier: msg.EpochIdentifier,
+		Recurrence:      recurrence,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMarketTemplateResponse{TemplateId: id}, nil
+}
+
+// SetMarketTemplateActive pauses or resumes a template. Pausing refunds the
+// bond escrowed for the next market. A resumed template escrows it again,
+// waits a full recurrence before creating its next market and is held to the
+// current template limits.
+func (k msgServer) SetMarketTemplateActive(goCtx context.Context, msg *types.MsgSetMarketTemplateActive) (*types.MsgSetMarketTemplateActiveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tmpl, found := k.GetMarketTemplate(ctx, msg.TemplateId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTemplateNotFound, "template %d", msg.TemplateId)
+	}
+	if tmpl.Creator != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only the creator of template %d can update it", msg.TemplateId)
+	}
+
+	if msg.Active && !tmpl.Active {
+		if err := k.CheckActiveTemplates(ctx, tmpl.Creator); err != nil {
+			return nil, err
+		}
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !params.TemplateLimits.CoversMinBond(tmpl.Bond) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "bond must be at least %s", params.TemplateLimits.MinBond)
+		}
+		epoch, err := k.currentEpoch(ctx, tmpl.EpochIdentifier)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.escrowTemplateBond(ctx, &tmpl); err != nil {
+			return nil, err
+		}
+		tmpl.LastEpoch = epoch
+		tmpl.IdleMarkets = 0
+	}
+	if !msg.Active && tmpl.Active {
+		if err := k.refundTemplateBond(ctx, &tmpl); err != nil {
+			return nil, err
+		}
+	}
+	tmpl.Active = msg.Active
+	if err := k.SetMarketTemplate(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMarketTemplateActiveResponse{}, nil
+}
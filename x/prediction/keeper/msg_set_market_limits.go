@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+func (k msgServer) SetMarketLimits(goCtx context.Context, req *types.MsgSetMarketLimits) (*types.MsgSetMarketLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority, err := k.addressCodec.StringToBytes(req.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	}
+
+	market, found := k.GetPredictionMarket(ctx, req.MarketId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrMarketNotFound, "market %d not found", req.MarketId)
+	}
+	if req.PositionLimits != nil {
+		if err := req.PositionLimits.Validate(); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+		}
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !req.PositionLimits.IsTighterThan(params.PositionLimits) {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "market position limits cannot exceed the module limits")
+		}
+	}
+
+	market.PositionLimits = req.PositionLimits
+	k.SetPredictionMarket(ctx, market)
+
+	return &types.MsgSetMarketLimitsResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+// referencePrice picks the price that best reflects an outcome's value: the
+// mid of the book when both sides are quoted, otherwise the last trade, otherwise
+// whichever side of the book exists.
+func referencePrice(depth types.QueryDepthResponse) math.LegacyDec {
+	switch {
+	case depth.BestBid != "" && depth.BestAsk != "":
+		return parsePrice(depth.BestBid).Add(parsePrice(depth.BestAsk)).QuoInt64(2)
+	case depth.LastPrice != "":
+		return parsePrice(depth.LastPrice)
+	case depth.BestBid != "":
+		return parsePrice(depth.BestBid)
+	case depth.BestAsk != "":
+		return parsePrice(depth.BestAsk)
+	default:
+		return math.LegacyZeroDec()
+	}
+}
+
+// GetMarketPrices returns the per-outcome prices of a market together with an
+// implied probability vector that sums to exactly one, and the overround of the
+// raw prices. The overround is zero when no outcome has a price.
+func (k Keeper) GetMarketPrices(ctx sdk.Context, market types.PredictionMarket) ([]types.OutcomePrice, math.LegacyDec) {
+	prices := make([]types.OutcomePrice, len(market.Outcomes))
+	raw := make([]math.LegacyDec, len(market.Outcomes))
+	total := math.LegacyZeroDec()
+
+	for i, outcome := range market.Outcomes {
+		depth := k.GetDepth(ctx, market.Id, uint32(i), 1)
+		raw[i] = referencePrice(depth)
+		total = total.Add(raw[i])
+		prices[i] = types.OutcomePrice{
+			OutcomeIndex: uint32(i),
+			Outcome:      outcome,
+			BestBid:      depth.BestBid,
+			BestAsk:      depth.BestAsk,
+			LastPrice:    depth.LastPrice,
+			Price:        raw[i].String(),
+		}
+	}
+
+	if len(prices) == 0 {
+		return prices, math.LegacyZeroDec()
+	}
+	overround := math.LegacyZeroDec()
+	if !total.IsZero() {
+		overround = total.Sub(math.LegacyOneDec())
+	}
+
+	// Normalize, assigning the rounding remainder to the last outcome so the
+	// vector sums to one. Without any price information every outcome is
+	// considered equally likely.
+	assigned := math.LegacyZeroDec()
+	for i := range prices {
+		var probability math.LegacyDec
+		switch {
+		case i == len(prices)-1:
+			probability = math.LegacyOneDec().Sub(assigned)
+		case total.IsZero():
+			probability = math.LegacyOneDec().QuoInt64(int64(len(prices)))
+		default:
+			probability = raw[i].Quo(total)
+		}
+		assigned = assigned.Add(probability)
+		prices[i].ImpliedProbability = probability.String()
+	}
+
+	return prices, overround
+}
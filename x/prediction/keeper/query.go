@@ -2,10 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"speculod/x/prediction/types"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = queryServer{}
@@ -60,31 +65,21 @@ func (q queryServer) Order(goCtx context.Context, req *types.QueryOrderRequest)
 
 func (q queryServer) Orders(goCtx context.Context, req *types.QueryOrdersRequest) (*types.QueryOrdersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	allOrders := q.k.GetOrdersByMarketAndOutcome(ctx, req.MarketId, req.OutcomeIndex)
-	var filteredOrders []types.Order
-	for _, order := range allOrders {
-		if order.Status == types.ORDER_STATUS_OPEN || order.Status == types.ORDER_STATUS_PARTIALLY_FILLED {
-			filteredOrders = append(filteredOrders, order)
-		}
-	}
 	return &types.QueryOrdersResponse{
-		Orders:     filteredOrders,
+		Orders:     q.k.GetRestingOrdersByMarketAndOutcome(ctx, req.MarketId, req.OutcomeIndex),
 		Pagination: nil, // Add pagination if needed
 	}, nil
 }
 
 func (q queryServer) OrderBook(goCtx context.Context, req *types.QueryOrderBookRequest) (*types.QueryOrderBookResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	orders := q.k.GetOrdersByMarketAndOutcome(ctx, req.MarketId, req.OutcomeIndex)
+	bidOrders, askOrders := q.k.GetRestingOrders(ctx, req.MarketId, req.OutcomeIndex)
 	var bids, asks []*types.Order
-	for i := range orders {
-		if orders[i].Status == types.ORDER_STATUS_OPEN || orders[i].Status == types.ORDER_STATUS_PARTIALLY_FILLED {
-			if orders[i].Side == types.ORDER_SIDE_BUY {
-				bids = append(bids, &orders[i])
-			} else if orders[i].Side == types.ORDER_SIDE_SELL {
-				asks = append(asks, &orders[i])
-			}
-		}
+	for i := range bidOrders {
+		bids = append(bids, &bidOrders[i])
+	}
+	for i := range askOrders {
+		asks = append(asks, &askOrders[i])
 	}
 	orderBook := types.OrderBook{
 		MarketId:     req.MarketId,
@@ -109,3 +104,106 @@ func (q queryServer) UserOrders(goCtx context.Context, req *types.QueryUserOrder
 		Pagination: nil, // Add pagination if needed
 	}, nil
 }
+
+func (q queryServer) Depth(goCtx context.Context, req *types.QueryDepthRequest) (*types.QueryDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, found := q.k.GetPredictionMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "market %d not found", req.MarketId)
+	}
+	if req.OutcomeIndex >= uint32(len(market.Outcomes)) {
+		return nil, status.Errorf(codes.InvalidArgument, "outcome index %d out of range", req.OutcomeIndex)
+	}
+	depth := q.k.GetDepth(ctx, req.MarketId, req.OutcomeIndex, req.Levels)
+	return &depth, nil
+}
+
+func (q queryServer) SimulateOrder(goCtx context.Context, req *types.QuerySimulateOrderRequest) (*types.QuerySimulateOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Run the regular PostOrder path on a branch of the store that is never written back
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := NewMsgServerImpl(q.k).PostOrder(cacheCtx, &types.MsgPostOrder{
+		Creator:      req.Creator,
+		MarketId:     req.MarketId,
+		OutcomeIndex: req.OutcomeIndex,
+		Side:         req.Side,
+		Price:        req.Price,
+		Amount:       req.Amount,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denom := req.Amount.Denom
+	filled := math.ZeroInt()
+	notional := math.LegacyZeroDec()
+	fills := make([]types.Trade, 0, len(res.Trades))
+	for _, trade := range res.Trades {
+		fills = append(fills, *trade)
+		filled = filled.Add(trade.Amount.Amount)
+		notional = notional.Add(parsePrice(trade.Price).MulInt(trade.Amount.Amount))
+	}
+
+	var averagePrice string
+	if filled.IsPositive() {
+		averagePrice = notional.QuoInt(filled).String()
+	}
+
+	depth := q.k.GetDepth(cacheCtx, req.MarketId, req.OutcomeIndex, 1)
+
+	return &types.QuerySimulateOrderResponse{
+		Fills:           fills,
+		AveragePrice:    averagePrice,
+		FilledAmount:    sdk.NewCoin(denom, filled),
+		RemainingAmount: sdk.NewCoin(denom, req.Amount.Amount.Sub(filled)),
+		// No trading fees are charged by the module yet
+		Fees:    sdk.NewCoin(denom, math.ZeroInt()),
+		BestBid: depth.BestBid,
+		BestAsk: depth.BestAsk,
+	}, nil
+}
+
+func (q queryServer) MarketPrices(goCtx context.Context, req *types.QueryMarketPricesRequest) (*types.QueryMarketPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, found := q.k.GetPredictionMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "market %d not found", req.MarketId)
+	}
+	prices, overround := q.k.GetMarketPrices(ctx, market)
+	return &types.QueryMarketPricesResponse{
+		MarketId:  req.MarketId,
+		Prices:    prices,
+		Overround: overround.String(),
+	}, nil
+}
+
+func (q queryServer) TWAP(goCtx context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	twap, start, err := q.k.TWAP(ctx, req.MarketId, req.OutcomeIndex, time.Duration(req.WindowSeconds)*time.Second)
+	switch {
+	case errors.Is(err, types.ErrTwapUnavailable):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, types.ErrInvalidRequest):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTWAPResponse{
+		Twap:      twap.String(),
+		StartTime: start,
+		EndTime:   ctx.BlockTime().Unix(),
+	}, nil
+}
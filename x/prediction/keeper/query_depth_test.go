@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestDepthQuery(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: ctx.BlockTime().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	post := func(creator, side, price string, amount int64) {
+		coin := sdk.NewInt64Coin("stake", amount)
+		_, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:  creator,
+			MarketId: created.MarketId,
+			Side:     side,
+			Price:    price,
+			Amount:   &coin,
+		})
+		require.NoError(t, err)
+	}
+
+	post("alice", "BUY", "0.40", 10)
+	post("bob", "BUY", "0.45", 5)
+	post("carol", "BUY", "0.4", 20)
+	post("dave", "SELL", "0.60", 7)
+	post("erin", "SELL", "0.55", 3)
+	// Crosses the best bid for 2, leaving 3 resting at 0.45
+	post("frank", "SELL", "0.45", 2)
+
+	res, err := qs.Depth(ctx, &types.QueryDepthRequest{MarketId: created.MarketId})
+	require.NoError(t, err)
+
+	require.Len(t, res.Bids, 2)
+	require.Equal(t, "0.450000000000000000", res.Bids[0].Price)
+	require.Equal(t, math.NewInt(3), res.Bids[0].TotalAmount.Amount)
+	require.Equal(t, uint32(1), res.Bids[0].OrderCount)
+	require.Equal(t, "0.400000000000000000", res.Bids[1].Price)
+	require.Equal(t, math.NewInt(30), res.Bids[1].TotalAmount.Amount)
+	require.Equal(t, uint32(2), res.Bids[1].OrderCount)
+
+	require.Len(t, res.Asks, 2)
+	require.Equal(t, "0.550000000000000000", res.Asks[0].Price)
+	require.Equal(t, "0.600000000000000000", res.Asks[1].Price)
+
+	require.Equal(t, "0.450000000000000000", res.BestBid)
+	require.Equal(t, "0.550000000000000000", res.BestAsk)
+	require.Equal(t, "0.100000000000000000", res.Spread)
+	require.Equal(t, "0.450000000000000000", res.LastPrice)
+	require.Equal(t, "2", res.Volume24H)
+
+	// Levels caps each side of the ladder
+	res, err = qs.Depth(ctx, &types.QueryDepthRequest{MarketId: created.MarketId, Levels: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Bids, 1)
+	require.Len(t, res.Asks, 1)
+
+	// Trades older than the volume window no longer count
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(keeper.VolumeWindow + time.Second))
+	res, err = qs.Depth(later, &types.QueryDepthRequest{MarketId: created.MarketId})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.Volume24H)
+	require.Equal(t, "0.450000000000000000", res.LastPrice)
+
+	// The raw order book follows the same ordering
+	book, err := qs.OrderBook(ctx, &types.QueryOrderBookRequest{MarketId: created.MarketId})
+	require.NoError(t, err)
+	require.Equal(t, "bob", book.OrderBook.Bids[0].Creator)
+	require.Equal(t, "alice", book.OrderBook.Bids[1].Creator)
+	require.Equal(t, "erin", book.OrderBook.Asks[0].Creator)
+
+	_, err = qs.Depth(ctx, &types.QueryDepthRequest{MarketId: 99})
+	require.Error(t, err)
+	_, err = qs.Depth(ctx, &types.QueryDepthRequest{MarketId: created.MarketId, OutcomeIndex: 2})
+	require.Error(t, err)
+}
+
+func TestGetTradesByMarketAndOutcome(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	coin := sdk.NewInt64Coin("stake", 1)
+	for id, key := range [][2]uint64{{2, 0}, {10, 0}, {2, 1}, {2, 0}, {1, 0}} {
+		f.keeper.SetTrade(ctx, types.Trade{TradeId: uint64(id), MarketId: key[0], OutcomeIndex: uint32(key[1]), Price: "0.5", Amount: &coin})
+	}
+
+	trades := f.keeper.GetTradesByMarketAndOutcome(ctx, 2, 0)
+	require.Len(t, trades, 2)
+	require.Equal(t, uint64(0), trades[0].TradeId)
+	require.Equal(t, uint64(3), trades[1].TradeId)
+	require.Len(t, f.keeper.GetTradesByMarketAndOutcome(ctx, 10, 0), 1)
+	require.Empty(t, f.keeper.GetTradesByMarketAndOutcome(ctx, 10, 1))
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/x/prediction/types"
+)
+
+func (q queryServer) Group(ctx context.Context, req *types.QueryGroupRequest) (*types.QueryGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	g, found := q.k.GetMarketGroup(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "group %s not found", req.Id)
+	}
+	return &types.QueryGroupResponse{Group: g}, nil
+}
+
+func (q queryServer) Groups(ctx context.Context, req *types.QueryGroupsRequest) (*types.QueryGroupsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	groups, pageRes, err := query.CollectionPaginate(ctx, q.k.Groups, req.Pagination,
+		func(_ string, g types.MarketGroup) (types.MarketGroup, error) { return g, nil })
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryGroupsResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GroupMembers(ctx context.Context, req *types.QueryGroupMembersRequest) (*types.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, found := q.k.GetMarketGroup(ctx, req.Id); !found {
+		return nil, status.Errorf(codes.NotFound, "group %s not found", req.Id)
+	}
+	res, err := q.k.GetGroupMembers(ctx, req.Id, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryGroupMembersResponse{Members: res.Members, Pagination: res.Pagination}, nil
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestMarketPricesQuery(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Who wins?",
+		Outcomes: []string{"A", "B", "C"},
+		Deadline: ctx.BlockTime().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	// Without any orders every outcome is equally likely
+	res, err := qs.MarketPrices(ctx, &types.QueryMarketPricesRequest{MarketId: created.MarketId})
+	require.NoError(t, err)
+	require.Len(t, res.Prices, 3)
+	require.Equal(t, "0.333333333333333333", res.Prices[0].ImpliedProbability)
+	require.Equal(t, "0.333333333333333334", res.Prices[2].ImpliedProbability)
+
+	post := func(outcome uint32, side, price string) {
+		coin := sdk.NewInt64Coin("stake", 5)
+		_, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:      "trader",
+			MarketId:     created.MarketId,
+			OutcomeIndex: outcome,
+			Side:         side,
+			Price:        price,
+			Amount:       &coin,
+		})
+		require.NoError(t, err)
+	}
+
+	// A: two-sided book, mid 0.55
+	post(0, "BUY", "0.5")
+	post(0, "SELL", "0.6")
+	// B: only a trade at 0.3
+	post(1, "SELL", "0.3")
+	post(1, "BUY", "0.3")
+	// C: only an ask at 0.25
+	post(2, "SELL", "0.25")
+
+	res, err = qs.MarketPrices(ctx, &types.QueryMarketPricesRequest{MarketId: created.MarketId})
+	require.NoError(t, err)
+	require.Equal(t, created.MarketId, res.MarketId)
+	require.Equal(t, "0.100000000000000000", res.Overround)
+
+	require.Equal(t, "A", res.Prices[0].Outcome)
+	require.Equal(t, "0.500000000000000000", res.Prices[0].BestBid)
+	require.Equal(t, "0.600000000000000000", res.Prices[0].BestAsk)
+	require.Equal(t, "0.550000000000000000", res.Prices[0].Price)
+	require.Equal(t, "0.500000000000000000", res.Prices[0].ImpliedProbability)
+
+	require.Empty(t, res.Prices[1].BestBid)
+	require.Empty(t, res.Prices[1].BestAsk)
+	require.Equal(t, "0.300000000000000000", res.Prices[1].LastPrice)
+	require.Equal(t, "0.300000000000000000", res.Prices[1].Price)
+
+	require.Equal(t, "0.250000000000000000", res.Prices[2].Price)
+
+	sum := math.LegacyZeroDec()
+	for _, p := range res.Prices {
+		sum = sum.Add(math.LegacyMustNewDecFromStr(p.ImpliedProbability))
+	}
+	require.True(t, sum.Equal(math.LegacyOneDec()))
+
+	_, err = qs.MarketPrices(ctx, &types.QueryMarketPricesRequest{MarketId: 42})
+	require.Error(t, err)
+}
+
+func TestMarketPricesEmptyBook(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: ctx.BlockTime().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	res, err := qs.MarketPrices(ctx, &types.QueryMarketPricesRequest{MarketId: created.MarketId})
+	require.NoError(t, err)
+	for _, price := range res.Prices {
+		require.Equal(t, "0.500000000000000000", price.ImpliedProbability)
+		require.True(t, math.LegacyMustNewDecFromStr(price.Price).IsZero())
+	}
+	require.True(t, math.LegacyMustNewDecFromStr(res.Overround).IsZero())
+}
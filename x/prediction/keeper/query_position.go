@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/x/prediction/types"
+)
+
+func (q queryServer) Positions(ctx context.Context, req *types.QueryPositionsRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	prefix := collections.TriplePrefix[uint64, string, uint32](req.MarketId)
+	if req.Owner != "" {
+		prefix = collections.TripleSuperPrefix[uint64, string, uint32](req.MarketId, req.Owner)
+	}
+	positions, pageRes, err := query.CollectionPaginate(ctx, q.k.Positions, req.Pagination,
+		func(key collections.Triple[uint64, string, uint32], pos types.Position) (types.GenesisPosition, error) {
+			return types.GenesisPosition{OutcomeIndex: key.K3(), Position: pos}, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[uint64, string, uint32]]) {
+			o.Prefix = &prefix
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPositionsResponse{Positions: positions, Pagination: pageRes}, nil
+}
+
+func (q queryServer) Exposure(ctx context.Context, req *types.QueryExposureRequest) (*types.QueryExposureResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	exposure, err := q.k.GetExposure(ctx, req.MarketId, req.Account, req.OutcomeIndex)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryExposureResponse{Exposure: exposure}, nil
+}
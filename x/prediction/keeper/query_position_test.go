@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestPositionsQuery(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	set := func(marketId uint64, owner string, outcome uint32, amount int64) {
+		coin := sdk.NewInt64Coin("stake", amount)
+		f.keeper.SetPosition(ctx, types.Position{MarketId: marketId, Owner: owner, Amount: &coin}, outcome)
+	}
+	set(1, "alice", 0, 10)
+	set(1, "alice", 1, 4)
+	set(1, "bob", 1, 6)
+	set(2, "alice", 0, 99)
+
+	all, err := qs.Positions(ctx, &types.QueryPositionsRequest{MarketId: 1})
+	require.NoError(t, err)
+	require.Len(t, all.Positions, 3)
+
+	alice, err := qs.Positions(ctx, &types.QueryPositionsRequest{MarketId: 1, Owner: "alice"})
+	require.NoError(t, err)
+	require.Len(t, alice.Positions, 2)
+	require.Equal(t, uint32(1), alice.Positions[1].OutcomeIndex)
+	require.Equal(t, int64(4), alice.Positions[1].Position.Amount.Amount.Int64())
+
+	none, err := qs.Positions(ctx, &types.QueryPositionsRequest{MarketId: 2, Owner: "bob"})
+	require.NoError(t, err)
+	require.Empty(t, none.Positions)
+
+	page, err := qs.Positions(ctx, &types.QueryPositionsRequest{MarketId: 1, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, page.Positions, 2)
+	next, err := qs.Positions(ctx, &types.QueryPositionsRequest{MarketId: 1, Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, next.Positions, 1)
+	require.Equal(t, "bob", next.Positions[0].Position.Owner)
+
+	_, err = qs.Positions(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExposureQuery(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	exposure := types.NewExposure()
+	exposure.Position = math.NewInt(-25)
+	exposure.OpenBuy = math.NewInt(10)
+	exposure.OpenOrders = 1
+	require.NoError(t, f.keeper.Exposures.Set(ctx, collections.Join3(uint64(1), "alice", uint32(1)), exposure))
+
+	res, err := qs.Exposure(ctx, &types.QueryExposureRequest{MarketId: 1, OutcomeIndex: 1, Account: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, exposure, res.Exposure)
+
+	res, err = qs.Exposure(ctx, &types.QueryExposureRequest{MarketId: 1, OutcomeIndex: 0, Account: "alice"})
+	require.NoError(t, err)
+	require.True(t, res.Exposure.IsZero())
+
+	_, err = qs.Exposure(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestSimulateOrderQuery(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: ctx.BlockTime().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	for _, ask := range []struct {
+		creator string
+		price   string
+		amount  int64
+	}{
+		{"alice", "0.50", 10},
+		{"bob", "0.60", 10},
+		{"carol", "0.70", 10},
+	} {
+		coin := sdk.NewInt64Coin("stake", ask.amount)
+		_, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:  ask.creator,
+			MarketId: created.MarketId,
+			Side:     "SELL",
+			Price:    ask.price,
+			Amount:   &coin,
+		})
+		require.NoError(t, err)
+	}
+	ordersBefore := f.keeper.GetAllOrders(ctx)
+
+	amount := sdk.NewInt64Coin("stake", 25)
+	res, err := qs.SimulateOrder(ctx, &types.QuerySimulateOrderRequest{
+		Creator:  "dave",
+		MarketId: created.MarketId,
+		Side:     "BUY",
+		Price:    "0.65",
+		Amount:   &amount,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res.Fills, 2)
+	require.Equal(t, "alice", res.Fills[0].Seller)
+	require.Equal(t, "bob", res.Fills[1].Seller)
+	require.Equal(t, "0.550000000000000000", res.AveragePrice)
+	require.Equal(t, math.NewInt(20), res.FilledAmount.Amount)
+	require.Equal(t, math.NewInt(5), res.RemainingAmount.Amount)
+	require.True(t, res.Fees.IsZero())
+	require.Equal(t, "0.650000000000000000", res.BestBid)
+	require.Equal(t, "0.700000000000000000", res.BestAsk)
+
+	// Nothing was written to the store
+	require.Equal(t, ordersBefore, f.keeper.GetAllOrders(ctx))
+	require.Empty(t, f.keeper.GetTradesByMarketAndOutcome(ctx, created.MarketId, 0))
+
+	// Validation errors from PostOrder are surfaced
+	_, err = qs.SimulateOrder(ctx, &types.QuerySimulateOrderRequest{
+		Creator:  "dave",
+		MarketId: created.MarketId,
+		Side:     "HOLD",
+		Price:    "0.65",
+		Amount:   &amount,
+	})
+	require.Error(t, err)
+}
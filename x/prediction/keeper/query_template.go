@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/x/prediction/types"
+)
+
+func (q queryServer) MarketTemplate(ctx context.Context, req *types.QueryMarketTemplateRequest) (*types.QueryMarketTemplateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	tmpl, found := q.k.GetMarketTemplate(ctx, req.TemplateId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "template %d not found", req.TemplateId)
+	}
+	return &types.QueryMarketTemplateResponse{Template: tmpl}, nil
+}
+
+func (q queryServer) MarketTemplates(ctx context.Context, req *types.QueryMarketTemplatesRequest) (*types.QueryMarketTemplatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	templates, pageRes, err := query.CollectionPaginate(ctx, q.k.Templates, req.Pagination,
+		func(_ uint64, tmpl types.MarketTemplate) (types.MarketTemplate, error) { return tmpl, nil })
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryMarketTemplatesResponse{Templates: templates, Pagination: pageRes}, nil
+}
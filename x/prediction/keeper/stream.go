@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/x/prediction/types"
+)
+
+// StreamBufferSize is the number of updates buffered per subscriber. A
+// subscriber that falls further behind is disconnected.
+const StreamBufferSize = 256
+
+var (
+	_ types.StreamServer      = (*StreamServer)(nil)
+	_ storetypes.ABCIListener = (*StreamServer)(nil)
+)
+
+// streamKey identifies a market outcome
+type streamKey struct {
+	marketId     uint64
+	outcomeIndex uint32
+}
+
+// streamBook is the last order book sent to the subscribers of an outcome
+type streamBook struct {
+	height int64
+	bids   []types.OrderBookEntry
+	asks   []types.OrderBookEntry
+}
+
+type streamSubscriber struct {
+	keys    map[streamKey]struct{}
+	updates chan *types.StreamOrderBookUpdate
+}
+
+// StreamServer pushes order book diffs and trades to gRPC subscribers. It is
+// registered as an ABCI listener on the prediction store and computes the
+// updates of every subscribed outcome once its block has been committed.
+type StreamServer struct {
+	k        Keeper
+	queryCtx func() (sdk.Context, error)
+
+	mu          sync.Mutex
+	books       map[streamKey]streamBook
+	subscribers map[*streamSubscriber]struct{}
+}
+
+// NewStreamServer returns a StreamServer reading the order book through k.
+// queryCtx must return a context on the latest committed state; it is used to
+// load the book of outcomes nobody was subscribed to yet.
+func NewStreamServer(k Keeper, queryCtx func() (sdk.Context, error)) *StreamServer {
+	return &StreamServer{
+		k:           k,
+		queryCtx:    queryCtx,
+		books:       make(map[streamKey]streamBook),
+		subscribers: make(map[*streamSubscriber]struct{}),
+	}
+}
+
+// OrderBookUpdates sends a snapshot of every subscribed outcome, then its
+// diffs until the client goes away or falls behind.
+func (s *StreamServer) OrderBookUpdates(req *types.StreamOrderBookRequest, stream types.Stream_OrderBookUpdatesServer) error {
+	if req == nil || len(req.Subscriptions) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one subscription is required")
+	}
+
+	sub, snapshots, err := s.subscribe(req.Subscriptions)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer s.unsubscribe(sub)
+
+	for _, update := range snapshots {
+		if err := stream.Send(update); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case update, ok := <-sub.updates:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber fell behind")
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// subscribe registers a subscriber and returns the snapshots to send it first
+func (s *StreamServer) subscribe(subscriptions []types.StreamSubscription) (*streamSubscriber, []*types.StreamOrderBookUpdate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &streamSubscriber{
+		keys:    make(map[streamKey]struct{}),
+		updates: make(chan *types.StreamOrderBookUpdate, StreamBufferSize),
+	}
+	var (
+		snapshots []*types.StreamOrderBookUpdate
+		ctx       sdk.Context
+		loaded    bool
+	)
+	for _, subscription := range subscriptions {
+		key := streamKey{subscription.MarketId, subscription.OutcomeIndex}
+		if _, dup := sub.keys[key]; dup {
+			continue
+		}
+		sub.keys[key] = struct{}{}
+
+		// Outcomes already streamed to someone else share their book so all
+		// subscribers apply the same diffs
+		book, tracked := s.books[key]
+		if !tracked {
+			if !loaded {
+				var err error
+				if ctx, err = s.queryCtx(); err != nil {
+					return nil, nil, err
+				}
+				loaded = true
+			}
+			book = s.loadBook(ctx, key)
+			s.books[key] = book
+		}
+		snapshots = append(snapshots, &types.StreamOrderBookUpdate{
+			Height:       book.height,
+			MarketId:     key.marketId,
+			OutcomeIndex: key.outcomeIndex,
+			Snapshot:     true,
+			Bids:         book.bids,
+			Asks:         book.asks,
+		})
+	}
+	s.subscribers[sub] = struct{}{}
+	return sub, snapshots, nil
+}
+
+// unsubscribe removes a subscriber and forgets the books nobody follows anymore
+func (s *StreamServer) unsubscribe(sub *streamSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeSubscriber(sub)
+}
+
+func (s *StreamServer) removeSubscriber(sub *streamSubscriber) {
+	if _, ok := s.subscribers[sub]; !ok {
+		return
+	}
+	delete(s.subscribers, sub)
+	for key := range sub.keys {
+		if !s.followed(key) {
+			delete(s.books, key)
+		}
+	}
+}
+
+// followed reports whether any subscriber follows key
+func (s *StreamServer) followed(key streamKey) bool {
+	for other := range s.subscribers {
+		if _, ok := other.keys[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *StreamServer) loadBook(ctx sdk.Context, key streamKey) streamBook {
+	bids, asks := s.k.GetRestingOrders(ctx, key.marketId, key.outcomeIndex)
+	return streamBook{
+		height: ctx.BlockHeight(),
+		bids:   aggregateLevels(bids, 0),
+		asks:   aggregateLevels(asks, 0),
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener. Updates are only
+// sent once a block is committed.
+func (s *StreamServer) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener. It finds the outcomes whose
+// orders or trades changed in the block and sends their diffs.
+func (s *StreamServer) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.books) == 0 {
+		return nil
+	}
+
+	changed := make(map[streamKey]struct{})
+	trades := make(map[streamKey][]types.Trade)
+	for _, pair := range changeSet {
+		if pair.StoreKey != types.StoreKey || pair.Delete {
+			continue
+		}
+		switch {
+		case bytes.HasPrefix(pair.Key, s.k.Orders.GetPrefix()):
+			order, err := s.k.Orders.ValueCodec().Decode(pair.Value)
+			if err != nil {
+				return err
+			}
+			changed[streamKey{order.MarketId, order.OutcomeIndex}] = struct{}{}
+		case bytes.HasPrefix(pair.Key, s.k.Trades.GetPrefix()):
+			trade, err := s.k.Trades.ValueCodec().Decode(pair.Value)
+			if err != nil {
+				return err
+			}
+			key := streamKey{trade.MarketId, trade.OutcomeIndex}
+			changed[key] = struct{}{}
+			trades[key] = append(trades[key], trade)
+		}
+	}
+
+	keys := make([]streamKey, 0, len(changed))
+	for key := range changed {
+		if _, tracked := s.books[key]; tracked {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].marketId != keys[j].marketId {
+			return keys[i].marketId < keys[j].marketId
+		}
+		return keys[i].outcomeIndex < keys[j].outcomeIndex
+	})
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	for _, key := range keys {
+		prev := s.books[key]
+		book := s.loadBook(sdkCtx, key)
+		s.books[key] = book
+
+		keyTrades := trades[key]
+		sort.Slice(keyTrades, func(i, j int) bool { return keyTrades[i].TradeId < keyTrades[j].TradeId })
+		update := &types.StreamOrderBookUpdate{
+			Height:       book.height,
+			MarketId:     key.marketId,
+			OutcomeIndex: key.outcomeIndex,
+			Bids:         diffLevels(prev.bids, book.bids),
+			Asks:         diffLevels(prev.asks, book.asks),
+			Trades:       keyTrades,
+		}
+		if len(update.Bids) == 0 && len(update.Asks) == 0 && len(update.Trades) == 0 {
+			continue
+		}
+		s.broadcast(key, update)
+	}
+	return nil
+}
+
+// broadcast sends an update to the subscribers of key without blocking the
+// commit; subscribers whose buffer is full are dropped.
+func (s *StreamServer) broadcast(key streamKey, update *types.StreamOrderBookUpdate) {
+	for sub := range s.subscribers {
+		if _, ok := sub.keys[key]; !ok {
+			continue
+		}
+		select {
+		case sub.updates <- update:
+		default:
+			close(sub.updates)
+			s.removeSubscriber(sub)
+		}
+	}
+}
+
+// diffLevels returns the levels of next that differ from prev, plus an empty
+// level for every price that disappeared.
+func diffLevels(prev, next []types.OrderBookEntry) []types.OrderBookEntry {
+	old := make(map[string]types.OrderBookEntry, len(prev))
+	for _, level := range prev {
+		old[level.Price] = level
+	}
+
+	var diff []types.OrderBookEntry
+	for _, level := range next {
+		before, ok := old[level.Price]
+		delete(old, level.Price)
+		if ok && before.OrderCount == level.OrderCount && before.TotalAmount.Equal(level.TotalAmount) {
+			continue
+		}
+		diff = append(diff, level)
+	}
+	for _, level := range prev {
+		if _, removed := old[level.Price]; !removed {
+			continue
+		}
+		empty := sdk.NewInt64Coin(level.TotalAmount.Denom, 0)
+		diff = append(diff, types.OrderBookEntry{Price: level.Price, TotalAmount: &empty})
+	}
+	return diff
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// fakeUpdatesStream collects the updates sent to a subscriber
+type fakeUpdatesStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *types.StreamOrderBookUpdate
+}
+
+func (s *fakeUpdatesStream) Context() context.Context { return s.ctx }
+
+func (s *fakeUpdatesStream) Send(update *types.StreamOrderBookUpdate) error {
+	s.updates <- update
+	return nil
+}
+
+// changeSet encodes the given orders and trades as the store writes of a block
+func changeSet(t *testing.T, k keeper.Keeper, orders []types.Order, trades []types.Trade) []*storetypes.StoreKVPair {
+	t.Helper()
+	var pairs []*storetypes.StoreKVPair
+	for _, order := range orders {
+		key, err := collections.EncodeKeyWithPrefix(k.Orders.GetPrefix(), k.Orders.KeyCodec(), order.Id)
+		require.NoError(t, err)
+		value, err := k.Orders.ValueCodec().Encode(order)
+		require.NoError(t, err)
+		pairs = append(pairs, &storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: key, Value: value})
+	}
+	for _, trade := range trades {
+		key, err := collections.EncodeKeyWithPrefix(k.Trades.GetPrefix(), k.Trades.KeyCodec(), trade.TradeId)
+		require.NoError(t, err)
+		value, err := k.Trades.ValueCodec().Encode(trade)
+		require.NoError(t, err)
+		pairs = append(pairs, &storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: key, Value: value})
+	}
+	return pairs
+}
+
+func TestStreamServer(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0)).WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	server := keeper.NewStreamServer(f.keeper, func() (sdk.Context, error) { return ctx, nil })
+
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 2_000,
+	})
+	require.NoError(t, err)
+	post := func(ctx sdk.Context, outcome uint32, side, price string, amount int64) *types.MsgPostOrderResponse {
+		coin := sdk.NewInt64Coin("stake", amount)
+		res, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:      "trader",
+			MarketId:     created.MarketId,
+			OutcomeIndex: outcome,
+			Side:         side,
+			Price:        price,
+			Amount:       &coin,
+		})
+		require.NoError(t, err)
+		return res
+	}
+	post(ctx, 0, "SELL", "0.6", 10)
+
+	// Nobody is subscribed yet, so commits are ignored
+	require.NoError(t, server.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream := &fakeUpdatesStream{ctx: streamCtx, updates: make(chan *types.StreamOrderBookUpdate, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- server.OrderBookUpdates(&types.StreamOrderBookRequest{
+			Subscriptions: []types.StreamSubscription{{MarketId: created.MarketId, OutcomeIndex: 0}},
+		}, stream)
+	}()
+
+	snapshot := <-stream.updates
+	require.True(t, snapshot.Snapshot)
+	require.Equal(t, int64(10), snapshot.Height)
+	require.Empty(t, snapshot.Bids)
+	require.Len(t, snapshot.Asks, 1)
+	require.Equal(t, "0.600000000000000000", snapshot.Asks[0].Price)
+
+	// Block 11: a bid rests, a buy takes part of the ask, outcome 1 gets an order
+	block := ctx.WithBlockHeight(11)
+	bid := post(block, 0, "BUY", "0.4", 5)
+	take := post(block, 0, "BUY", "0.6", 4)
+	post(block, 1, "BUY", "0.3", 1)
+	var orders []types.Order
+	for _, id := range []uint64{0, bid.OrderId, take.OrderId, 3} {
+		order, found := f.keeper.GetOrder(block, id)
+		require.True(t, found)
+		orders = append(orders, order)
+	}
+	require.NoError(t, server.ListenCommit(block, abci.ResponseCommit{}, changeSet(t, f.keeper, orders, []types.Trade{*take.Trades[0]})))
+
+	update := <-stream.updates
+	require.False(t, update.Snapshot)
+	require.Equal(t, int64(11), update.Height)
+	require.Len(t, update.Bids, 1)
+	require.Equal(t, "0.400000000000000000", update.Bids[0].Price)
+	require.Len(t, update.Asks, 1)
+	require.Equal(t, int64(6), update.Asks[0].TotalAmount.Amount.Int64())
+	require.Len(t, update.Trades, 1)
+	require.Equal(t, take.OrderId, update.Trades[0].BuyOrderId)
+
+	// Block 12: the rest of the ask is cancelled and the level disappears
+	block = ctx.WithBlockHeight(12)
+	_, err = ms.CancelOrder(block, &types.MsgCancelOrder{Creator: "trader", OrderId: 0})
+	require.NoError(t, err)
+	ask, _ := f.keeper.GetOrder(block, 0)
+	require.NoError(t, server.ListenCommit(block, abci.ResponseCommit{}, changeSet(t, f.keeper, []types.Order{ask}, nil)))
+	update = <-stream.updates
+	require.Empty(t, update.Bids)
+	require.Len(t, update.Asks, 1)
+	require.True(t, update.Asks[0].TotalAmount.IsZero())
+	require.Zero(t, update.Asks[0].OrderCount)
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Empty(t, stream.updates)
+
+	// Invalid requests are rejected
+	require.Error(t, server.OrderBookUpdates(&types.StreamOrderBookRequest{}, stream))
+}
+
+func TestStreamServerDropsSlowSubscribers(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	server := keeper.NewStreamServer(f.keeper, func() (sdk.Context, error) { return ctx, nil })
+
+	created, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 2_000,
+	})
+	require.NoError(t, err)
+
+	// The subscriber never reads past its snapshot
+	stream := &fakeUpdatesStream{ctx: context.Background(), updates: make(chan *types.StreamOrderBookUpdate)}
+	done := make(chan error, 1)
+	go func() {
+		done <- server.OrderBookUpdates(&types.StreamOrderBookRequest{
+			Subscriptions: []types.StreamSubscription{{MarketId: created.MarketId}},
+		}, stream)
+	}()
+	<-stream.updates
+
+	coin := sdk.NewInt64Coin("stake", 1)
+	for i := 0; i <= keeper.StreamBufferSize+1; i++ {
+		res, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+			Creator:  "trader",
+			MarketId: created.MarketId,
+			Side:     "BUY",
+			Price:    "0.1",
+			Amount:   &coin,
+		})
+		require.NoError(t, err)
+		order, _ := f.keeper.GetOrder(ctx, res.OrderId)
+		require.NoError(t, server.ListenCommit(ctx, abci.ResponseCommit{}, changeSet(t, f.keeper, []types.Order{order}, nil)))
+	}
+
+	// Draining the stream ends with the subscriber being dropped
+	go func() {
+		for range stream.updates {
+		}
+	}()
+	require.Error(t, <-done)
+}
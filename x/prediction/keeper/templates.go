@@ -0,0 +1,321 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+// SetMarketTemplate stores a market template and, while it is active, indexes
+// it by creator and by the epoch its next market is due
+func (k Keeper) SetMarketTemplate(ctx context.Context, tmpl types.MarketTemplate) error {
+	prev, err := k.Templates.Get(ctx, tmpl.Id)
+	switch {
+	case err == nil && prev.Active:
+		if err := k.DueTemplates.Remove(ctx, dueTemplateKey(prev)); err != nil {
+			return err
+		}
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if err := k.Templates.Set(ctx, tmpl.Id, tmpl); err != nil {
+		return err
+	}
+	key := collections.Join(tmpl.Creator, tmpl.Id)
+	if tmpl.Active {
+		if err := k.DueTemplates.Set(ctx, dueTemplateKey(tmpl)); err != nil {
+			return err
+		}
+		return k.ActiveTemplates.Set(ctx, key)
+	}
+	return k.ActiveTemplates.Remove(ctx, key)
+}
+
+// dueTemplateKey is the key of a template in the index of due templates
+func dueTemplateKey(tmpl types.MarketTemplate) collections.Triple[string, int64, uint64] {
+	return collections.Join3(tmpl.EpochIdentifier, tmpl.LastEpoch+tmpl.Recurrence, tmpl.Id)
+}
+
+// GetMarketTemplate fetches a market template by ID
+func (k Keeper) GetMarketTemplate(ctx context.Context, id uint64) (types.MarketTemplate, bool) {
+	tmpl, err := k.Templates.Get(ctx, id)
+	if err != nil {
+		return types.MarketTemplate{}, false
+	}
+	return tmpl, true
+}
+
+// CheckActiveTemplates returns an error if creator cannot have another active template
+func (k Keeper) CheckActiveTemplates(ctx context.Context, creator string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	limit := params.TemplateLimits.MaxActivePerCreator
+	if limit == 0 {
+		return nil
+	}
+	var active uint32
+	rng := collections.NewPrefixedPairRange[string, uint64](creator)
+	if err := k.ActiveTemplates.Walk(ctx, rng, func(collections.Pair[string, uint64]) (bool, error) {
+		active++
+		return active >= limit, nil
+	}); err != nil {
+		return err
+	}
+	if active >= limit {
+		return errorsmod.Wrapf(types.ErrLimitExceeded, "%s already has %d active templates", creator, active)
+	}
+	return nil
+}
+
+// marketTraded reports whether a market has any trade
+func (k Keeper) marketTraded(ctx context.Context, marketId uint64) (bool, error) {
+	traded := false
+	rng := collections.NewPrefixedTripleRange[uint64, uint32, uint64](marketId)
+	err := k.TradesByOutcome.Walk(ctx, rng, func(collections.Triple[uint64, uint32, uint64]) (bool, error) {
+		traded = true
+		return true, nil
+	})
+	return traded, err
+}
+
+// currentEpoch returns the number of the running epoch of an x/epochs identifier
+func (k Keeper) currentEpoch(ctx sdk.Context, identifier string) (int64, error) {
+	info, err := k.epochsKeeper.GetEpochInfo(ctx, identifier)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidRequest, "unknown epoch identifier %s", identifier)
+	}
+	return info.CurrentEpoch, nil
+}
+
+// ProcessMarketTemplates creates a market from every active template whose
+// recurrence elapsed, or pauses the template when its last markets had no
+// trades. It runs after the x/epochs begin blocker, so an epoch
+// that ended in this block is already counted; x/epochs hooks are not used as
+// they cannot reach the epochs module through depinject. Only the templates
+// indexed as due by the current epoch of their identifier are visited. A
+// template that fails is skipped until its next recurrence instead of halting
+// the chain, and the recurrence counts as idle.
+func (k Keeper) ProcessMarketTemplates(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	epochs, err := k.epochsKeeper.AllEpochInfos(sdkCtx)
+	if err != nil {
+		return err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range epochs {
+		var due []uint64
+		rng := collections.NewPrefixedTripleRange[string, int64, uint64](info.Identifier)
+		err := k.DueTemplates.Walk(ctx, rng, func(key collections.Triple[string, int64, uint64]) (bool, error) {
+			if key.K2() > info.CurrentEpoch {
+				return true, nil
+			}
+			due = append(due, key.K3())
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, id := range due {
+			tmpl, err := k.Templates.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			if err := k.processTemplate(sdkCtx, tmpl, info.CurrentEpoch, params.TemplateLimits); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// processTemplate creates the market of a due template, or pauses the
+// template when too many of its recurrences in a row went idle
+func (k Keeper) processTemplate(ctx sdk.Context, tmpl types.MarketTemplate, epoch int64, limits types.TemplateLimits) error {
+	// Pause templates whose markets go unused instead of creating more
+	if tmpl.MarketsCreated > 0 && !tmpl.LastFailed {
+		traded, err := k.marketTraded(ctx, tmpl.LastMarketId)
+		if err != nil {
+			return err
+		}
+		if traded {
+			tmpl.IdleMarkets = 0
+		} else {
+			tmpl.IdleMarkets++
+		}
+	}
+	if limits.MaxIdleMarkets > 0 && tmpl.IdleMarkets >= uint64(limits.MaxIdleMarkets) {
+		if err := k.pauseTemplate(ctx, &tmpl); err != nil {
+			return err
+		}
+		return ctx.EventManager().EmitTypedEvent(&types.EventMarketTemplatePaused{
+			TemplateId:  tmpl.Id,
+			IdleMarkets: tmpl.IdleMarkets,
+		})
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	next := tmpl
+	if err := k.instantiateTemplate(cacheCtx, &next, epoch); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to create market from template %d: %s", tmpl.Id, err))
+		tmpl.IdleMarkets++
+		tmpl.LastFailed = true
+	} else {
+		write()
+		tmpl = next
+		tmpl.LastFailed = false
+	}
+	tmpl.LastEpoch = epoch
+	return k.SetMarketTemplate(ctx, tmpl)
+}
+
+// pauseTemplate deactivates a template and refunds the bond escrowed for its
+// next market. A bond that cannot be refunded stays escrowed for when the
+// template is resumed.
+func (k Keeper) pauseTemplate(ctx sdk.Context, tmpl *types.MarketTemplate) error {
+	tmpl.Active = false
+	if err := k.refundTemplateBond(ctx, tmpl); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to refund the bond of template %d: %s", tmpl.Id, err))
+	}
+	return k.SetMarketTemplate(ctx, *tmpl)
+}
+
+// escrowTemplateBond moves the bond of the next market of a template from its
+// creator to the module
+func (k Keeper) escrowTemplateBond(ctx context.Context, tmpl *types.MarketTemplate) error {
+	if tmpl.BondEscrowed || tmpl.Bond == nil || !tmpl.Bond.IsPositive() {
+		return nil
+	}
+	creator, err := k.addressCodec.StringToBytes(tmpl.Creator)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(*tmpl.Bond)); err != nil {
+		return errorsmod.Wrap(types.ErrInsufficientFunds, err.Error())
+	}
+	tmpl.BondEscrowed = true
+	return nil
+}
+
+// refundTemplateBond returns the bond escrowed for the next market of a
+// template to its creator
+func (k Keeper) refundTemplateBond(ctx context.Context, tmpl *types.MarketTemplate) error {
+	if !tmpl.BondEscrowed {
+		return nil
+	}
+	creator, err := k.addressCodec.StringToBytes(tmpl.Creator)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(*tmpl.Bond)); err != nil {
+		return errorsmod.Wrap(types.ErrTransferFailed, err.Error())
+	}
+	tmpl.BondEscrowed = false
+	return nil
+}
+
+// instantiateTemplate creates the market of a template for the epoch that
+// just ended. The market takes the bond escrowed with the template, rolls it
+// from the previous market or escrows a new one.
+func (k Keeper) instantiateTemplate(ctx sdk.Context, tmpl *types.MarketTemplate, epoch int64) error {
+	res, err := NewMsgServerImpl(k).CreateMarket(ctx, &types.MsgCreateMarket{
+		Creator:  tmpl.Creator,
+		Question: renderQuestion(tmpl.Question, epoch-1, ctx.BlockTime()),
+		Outcomes: tmpl.Outcomes,
+		GroupId:  tmpl.GroupId,
+		Deadline: ctx.BlockTime().Unix() + tmpl.Duration,
+	})
+	if err != nil {
+		return err
+	}
+	market, _ := k.GetPredictionMarket(ctx, res.MarketId)
+	market.TemplateId = tmpl.Id
+	market.FromTemplate = true
+
+	rolled := false
+	if tmpl.Bond != nil && tmpl.Bond.IsPositive() {
+		if !tmpl.BondEscrowed {
+			if rolled, err = k.rollBond(ctx, tmpl); err != nil {
+				return err
+			}
+		}
+		if !rolled {
+			if err := k.escrowTemplateBond(ctx, tmpl); err != nil {
+				return err
+			}
+		}
+		tmpl.BondEscrowed = false
+		bond := *tmpl.Bond
+		market.CreatorBond = &bond
+	}
+	k.SetPredictionMarket(ctx, market)
+
+	tmpl.LastMarketId = market.Id
+	tmpl.MarketsCreated++
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarketTemplateInstantiated{
+		TemplateId:      tmpl.Id,
+		MarketId:        market.Id,
+		EpochIdentifier: tmpl.EpochIdentifier,
+		EpochNumber:     epoch - 1,
+		BondRolled:      rolled,
+	})
+}
+
+// rollBond takes the bond off the previous market of a template when the
+// template rolls its bond and that market has not been resolved yet
+func (k Keeper) rollBond(ctx sdk.Context, tmpl *types.MarketTemplate) (bool, error) {
+	if !tmpl.RollBond || tmpl.MarketsCreated == 0 {
+		return false, nil
+	}
+	prev, err := k.Markets.Get(ctx, tmpl.LastMarketId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if prev.CreatorBond == nil || !prev.CreatorBond.Equal(*tmpl.Bond) {
+		return false, nil
+	}
+	prev.CreatorBond = nil
+	k.SetPredictionMarket(ctx, prev)
+	return true, nil
+}
+
+// refundCreatorBond returns the bond held for a market to its creator
+func (k Keeper) refundCreatorBond(ctx sdk.Context, market *types.PredictionMarket) error {
+	if market.CreatorBond == nil || !market.CreatorBond.IsPositive() {
+		return nil
+	}
+	creator, err := k.addressCodec.StringToBytes(market.Creator)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(*market.CreatorBond)); err != nil {
+		return errorsmod.Wrap(types.ErrTransferFailed, err.Error())
+	}
+	market.CreatorBond = nil
+	return nil
+}
+
+// renderQuestion fills the {epoch} and {date} placeholders of a template question
+func renderQuestion(pattern string, epoch int64, now time.Time) string {
+	return strings.NewReplacer(
+		"{epoch}", strconv.FormatInt(epoch, 10),
+		"{date}", now.UTC().Format(time.DateOnly),
+	).Replace(pattern)
+}
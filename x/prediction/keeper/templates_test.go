@@ -0,0 +1,340 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestMarketTemplates(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2024, 6, 7, 12, 0, 0, 0, time.UTC))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________"))
+	require.NoError(t, err)
+	f.bankKeeper.balances[creator] = sdk.NewCoins(sdk.NewInt64Coin("stake", 25))
+	f.epochsKeeper.setEpoch("week", 3)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(types.NoPositionLimits(), types.TemplateLimits{
+		MinBond: sdk.NewInt64Coin("stake", 10),
+	})))
+
+	bond := sdk.NewInt64Coin("stake", 10)
+	msg := &types.MsgCreateMarketTemplate{
+		Creator:         creator,
+		Question:        "Will ETH close above $4000 in week {epoch} ({date})?",
+		Outcomes:        []string{"Yes", "No"},
+		Duration:        3_600,
+		EpochIdentifier: "week",
+		Bond:            &bond,
+		RollBond:        true,
+	}
+
+	// The epoch identifier must exist
+	invalid := *msg
+	invalid.EpochIdentifier = "fortnight"
+	_, err = ms.CreateMarketTemplate(ctx, &invalid)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	invalid = *msg
+	invalid.Duration = 0
+	_, err = ms.CreateMarketTemplate(ctx, &invalid)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	// The bond must cover the minimum template bond
+	invalid = *msg
+	invalid.Bond = &sdk.Coin{Denom: "stake", Amount: bond.Amount.SubRaw(1)}
+	_, err = ms.CreateMarketTemplate(ctx, &invalid)
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+	invalid.Bond = nil
+	_, err = ms.CreateMarketTemplate(ctx, &invalid)
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	res, err := ms.CreateMarketTemplate(ctx, msg)
+	require.NoError(t, err)
+	tmpl, err := qs.MarketTemplate(ctx, &types.QueryMarketTemplateRequest{TemplateId: res.TemplateId})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), tmpl.Template.Recurrence)
+	require.Equal(t, int64(3), tmpl.Template.LastEpoch)
+
+	// Creating the template escrows the bond of its first market
+	require.True(t, tmpl.Template.BondEscrowed)
+	require.Equal(t, "15stake", f.bankKeeper.balances[creator].String())
+	require.Equal(t, "10stake", f.bankKeeper.balances[types.ModuleName].String())
+
+	// Nothing happens until the epoch ends
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	_, found := f.keeper.GetPredictionMarket(ctx, 0)
+	require.False(t, found)
+
+	// The end of epoch 3 creates the first market, which takes the escrowed bond
+	f.epochsKeeper.setEpoch("week", 4)
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	first, found := f.keeper.GetPredictionMarket(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, "Will ETH close above $4000 in week 3 (2024-06-07)?", first.Question)
+	require.Equal(t, ctx.BlockTime().Unix()+3_600, first.Deadline)
+	require.True(t, first.FromTemplate)
+	require.Equal(t, res.TemplateId, first.TemplateId)
+	require.Equal(t, bond, *first.CreatorBond)
+	require.Equal(t, "15stake", f.bankKeeper.balances[creator].String())
+	require.Equal(t, "10stake", f.bankKeeper.balances[types.ModuleName].String())
+	tmpl, err = qs.MarketTemplate(ctx, &types.QueryMarketTemplateRequest{TemplateId: res.TemplateId})
+	require.NoError(t, err)
+	require.False(t, tmpl.Template.BondEscrowed)
+
+	// Processing the same epoch again does not create another market
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	_, found = f.keeper.GetPredictionMarket(ctx, 1)
+	require.False(t, found)
+
+	// The bond of the unresolved market rolls to the next one
+	f.epochsKeeper.setEpoch("week", 5)
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	first, _ = f.keeper.GetPredictionMarket(ctx, 0)
+	require.Nil(t, first.CreatorBond)
+	second, found := f.keeper.GetPredictionMarket(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, bond, *second.CreatorBond)
+	require.Equal(t, "15stake", f.bankKeeper.balances[creator].String())
+
+	// Resolving a market refunds its bond, so the next market escrows a new one
+	require.NoError(t, f.keeper.ResolveMarket(ctx, 1, "Yes"))
+	require.Equal(t, "25stake", f.bankKeeper.balances[creator].String())
+	f.epochsKeeper.setEpoch("week", 6)
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	third, found := f.keeper.GetPredictionMarket(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, bond, *third.CreatorBond)
+	require.Equal(t, "15stake", f.bankKeeper.balances[creator].String())
+
+	// A creator that cannot pay the bond skips the epoch
+	require.NoError(t, f.keeper.ResolveMarket(ctx, 2, "No"))
+	f.bankKeeper.balances[creator] = sdk.NewCoins()
+	f.epochsKeeper.setEpoch("week", 7)
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	_, found = f.keeper.GetPredictionMarket(ctx, 3)
+	require.False(t, found)
+	tmpl, err = qs.MarketTemplate(ctx, &types.QueryMarketTemplateRequest{TemplateId: res.TemplateId})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), tmpl.Template.LastEpoch)
+	require.Equal(t, uint64(3), tmpl.Template.MarketsCreated)
+	require.True(t, tmpl.Template.LastFailed)
+
+	// Only the creator can pause a template, and paused templates are skipped
+	_, err = ms.SetMarketTemplateActive(ctx, &types.MsgSetMarketTemplateActive{Creator: "someone", TemplateId: res.TemplateId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetMarketTemplateActive(ctx, &types.MsgSetMarketTemplateActive{Creator: creator, TemplateId: res.TemplateId})
+	require.NoError(t, err)
+	f.bankKeeper.balances[creator] = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	f.epochsKeeper.setEpoch("week", 9)
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	_, found = f.keeper.GetPredictionMarket(ctx, 3)
+	require.False(t, found)
+
+	// A resumed template escrows its bond again and waits for the next epoch end
+	_, err = ms.SetMarketTemplateActive(ctx, &types.MsgSetMarketTemplateActive{Creator: creator, TemplateId: res.TemplateId, Active: true})
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.balances[creator].IsZero())
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	_, found = f.keeper.GetPredictionMarket(ctx, 3)
+	require.False(t, found)
+	f.epochsKeeper.setEpoch("week", 10)
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	fourth, found := f.keeper.GetPredictionMarket(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, bond, *fourth.CreatorBond)
+
+	// Pausing a template refunds the bond escrowed for its next market
+	f.bankKeeper.balances[creator] = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, f.keeper.ResolveMarket(ctx, 3, "No"))
+	_, err = ms.SetMarketTemplateActive(ctx, &types.MsgSetMarketTemplateActive{Creator: creator, TemplateId: res.TemplateId, Active: true})
+	require.NoError(t, err)
+	_, err = ms.SetMarketTemplateActive(ctx, &types.MsgSetMarketTemplateActive{Creator: creator, TemplateId: res.TemplateId})
+	require.NoError(t, err)
+	require.Equal(t, "20stake", f.bankKeeper.balances[creator].String())
+	require.NoError(t, f.keeper.AssertInvariants(ctx))
+
+	templates, err := qs.MarketTemplates(ctx, &types.QueryMarketTemplatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, templates.Templates, 1)
+}
+
+func TestMarketTemplateRecurrence(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________"))
+	require.NoError(t, err)
+	f.epochsKeeper.setEpoch("day", 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(types.NoPositionLimits(), types.TemplateLimits{})))
+
+	res, err := ms.CreateMarketTemplate(ctx, &types.MsgCreateMarketTemplate{
+		Creator:         creator,
+		Question:        "Will it rain?",
+		Outcomes:        []string{"Yes", "No"},
+		Duration:        60,
+		EpochIdentifier: "day",
+		Recurrence:      3,
+	})
+	require.NoError(t, err)
+
+	// The template is indexed by the epoch its next market is due
+	due := func(epoch int64) bool {
+		has, err := f.keeper.DueTemplates.Has(ctx, collections.Join3("day", epoch, res.TemplateId))
+		require.NoError(t, err)
+		return has
+	}
+	require.True(t, due(4))
+	for epoch := int64(2); epoch <= 7; epoch++ {
+		f.epochsKeeper.setEpoch("day", epoch)
+		require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	}
+	require.False(t, due(4))
+	require.True(t, due(10))
+	markets, err := keeper.NewQueryServerImpl(f.keeper).Markets(ctx, &types.QueryMarketsRequest{})
+	require.NoError(t, err)
+	require.Len(t, markets.Markets, 2)
+	require.Nil(t, markets.Markets[0].CreatorBond)
+}
+
+func TestMarketTemplateLimits(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________"))
+	require.NoError(t, err)
+	f.bankKeeper.balances[creator] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	f.epochsKeeper.setEpoch("day", 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(types.NoPositionLimits(), types.TemplateLimits{
+		MinBond:             sdk.NewInt64Coin("stake", 1),
+		MaxActivePerCreator: 2,
+		MaxIdleMarkets:      2,
+	})))
+
+	bond := sdk.NewInt64Coin("stake", 1)
+	create := func() (uint64, error) {
+		res, err := ms.CreateMarketTemplate(ctx, &types.MsgCreateMarketTemplate{
+			Creator:         creator,
+			Question:        "Will it rain on day {epoch}?",
+			Outcomes:        []string{"Yes", "No"},
+			Duration:        60,
+			EpochIdentifier: "day",
+			Bond:            &bond,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.TemplateId, nil
+	}
+
+	// A creator can only keep so many active templates
+	traded, err := create()
+	require.NoError(t, err)
+	idle, err := create()
+	require.NoError(t, err)
+	_, err = create()
+	require.ErrorIs(t, err, types.ErrLimitExceeded)
+
+	// Markets of the traded template get trades, those of the idle one do not
+	trade := func(marketId uint64) {
+		amount := sdk.NewInt64Coin("stake", 1)
+		for _, order := range []types.MsgPostOrder{
+			{Creator: "alice", Side: "BUY"},
+			{Creator: "bob", Side: "SELL"},
+		} {
+			order.MarketId, order.Price, order.Amount = marketId, "0.5", &amount
+			_, err := ms.PostOrder(ctx, &order)
+			require.NoError(t, err)
+		}
+	}
+	for epoch := int64(2); epoch <= 4; epoch++ {
+		f.epochsKeeper.setEpoch("day", epoch)
+		require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+		tmpl, _ := f.keeper.GetMarketTemplate(ctx, traded)
+		trade(tmpl.LastMarketId)
+	}
+
+	// The idle template is paused after two unused markets, freeing its slot
+	tmpl, _ := f.keeper.GetMarketTemplate(ctx, traded)
+	require.True(t, tmpl.Active)
+	require.Equal(t, uint64(3), tmpl.MarketsCreated)
+	tmpl, _ = f.keeper.GetMarketTemplate(ctx, idle)
+	require.False(t, tmpl.Active)
+	require.Equal(t, uint64(2), tmpl.MarketsCreated)
+	require.Equal(t, uint64(2), tmpl.IdleMarkets)
+	third, err := create()
+	require.NoError(t, err)
+
+	// Resuming counts against the cap again
+	_, err = ms.SetMarketTemplateActive(ctx, &types.MsgSetMarketTemplateActive{Creator: creator, TemplateId: idle, Active: true})
+	require.ErrorIs(t, err, types.ErrLimitExceeded)
+	_, err = ms.SetMarketTemplateActive(ctx, &types.MsgSetMarketTemplateActive{Creator: creator, TemplateId: third})
+	require.NoError(t, err)
+	_, err = ms.SetMarketTemplateActive(ctx, &types.MsgSetMarketTemplateActive{Creator: creator, TemplateId: idle, Active: true})
+	require.NoError(t, err)
+	tmpl, _ = f.keeper.GetMarketTemplate(ctx, idle)
+	require.Equal(t, uint64(0), tmpl.IdleMarkets)
+}
+
+func TestMarketTemplateFailures(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________"))
+	require.NoError(t, err)
+	f.bankKeeper.balances[creator] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	f.epochsKeeper.setEpoch("day", 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(types.NoPositionLimits(), types.TemplateLimits{
+		MaxIdleMarkets: 2,
+	})))
+
+	bond := sdk.NewInt64Coin("stake", 1)
+	res, err := ms.CreateMarketTemplate(ctx, &types.MsgCreateMarketTemplate{
+		Creator:         creator,
+		Question:        "Will it rain on day {epoch}?",
+		Outcomes:        []string{"Yes", "No"},
+		Duration:        60,
+		EpochIdentifier: "day",
+		Bond:            &bond,
+	})
+	require.NoError(t, err)
+
+	// The first market takes the escrowed bond and trades
+	f.epochsKeeper.setEpoch("day", 2)
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	amount := sdk.NewInt64Coin("stake", 1)
+	for _, order := range []types.MsgPostOrder{
+		{Creator: "alice", Side: "BUY"},
+		{Creator: "bob", Side: "SELL"},
+	} {
+		order.MarketId, order.Price, order.Amount = 0, "0.5", &amount
+		_, err := ms.PostOrder(ctx, &order)
+		require.NoError(t, err)
+	}
+
+	// The creator cannot pay the next bonds, and the failed recurrences count
+	// as idle although the last market traded
+	for epoch := int64(3); epoch <= 4; epoch++ {
+		f.epochsKeeper.setEpoch("day", epoch)
+		require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	}
+	tmpl, _ := f.keeper.GetMarketTemplate(ctx, res.TemplateId)
+	require.True(t, tmpl.Active)
+	require.True(t, tmpl.LastFailed)
+	require.Equal(t, uint64(1), tmpl.MarketsCreated)
+	require.Equal(t, uint64(2), tmpl.IdleMarkets)
+
+	f.epochsKeeper.setEpoch("day", 5)
+	require.NoError(t, f.keeper.ProcessMarketTemplates(ctx))
+	tmpl, _ = f.keeper.GetMarketTemplate(ctx, res.TemplateId)
+	require.False(t, tmpl.Active)
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"speculod/x/prediction/types"
+)
+
+// TwapRetention is how far back TWAP records are kept. It bounds the longest
+// window that can be queried.
+const TwapRetention = 48 * time.Hour
+
+// UpdateTwapAccumulators records the last trade price of every outcome traded
+// since the previous update, as known at the start of the block. It is called
+// from BeginBlock so trades only affect the average once they have persisted
+// across a block boundary. Outcomes that did not trade are not written to: a
+// record's price is taken to hold until the next record.
+func (k Keeper) UpdateTwapAccumulators(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	// Collect first so the store is not written while being iterated
+	iter, err := k.TradedOutcomes.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	traded, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, outcome := range traded {
+		if err := k.TradedOutcomes.Remove(ctx, outcome); err != nil {
+			return err
+		}
+		price, err := k.LastTradePrices.Get(ctx, outcome)
+		if err != nil {
+			return err
+		}
+		record := types.TwapRecord{
+			MarketId:        outcome.K1(),
+			OutcomeIndex:    outcome.K2(),
+			Price:           price,
+			Timestamp:       now,
+			CumulativePrice: math.LegacyZeroDec().String(),
+		}
+		if prev, found := k.latestTwapRecord(ctx, record.MarketId, record.OutcomeIndex, now); found {
+			if prev.Price == price {
+				continue
+			}
+			record.CumulativePrice = accumulatorAt(prev, now).String()
+		}
+
+		if err := k.TwapRecords.Set(ctx, collections.Join3(record.MarketId, record.OutcomeIndex, now), record); err != nil {
+			return err
+		}
+		if err := k.pruneTwapRecords(ctx, record.MarketId, record.OutcomeIndex, now-int64(TwapRetention.Seconds())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TWAP returns the time-weighted average trade price of a market outcome over
+// the window ending at the current block time. If less history is available
+// than requested, the window starts at the oldest record instead.
+func (k Keeper) TWAP(ctx context.Context, marketId uint64, outcomeIndex uint32, window time.Duration) (math.LegacyDec, int64, error) {
+	if window <= 0 || window > TwapRetention {
+		return math.LegacyDec{}, 0, errors.Wrapf(types.ErrInvalidRequest, "window must be between 1s and %s", TwapRetention)
+	}
+	end := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	start := end - int64(window.Seconds())
+
+	last, found := k.latestTwapRecord(ctx, marketId, outcomeIndex, end)
+	if !found {
+		return math.LegacyDec{}, 0, errors.Wrapf(types.ErrTwapUnavailable, "no price history for market %d outcome %d", marketId, outcomeIndex)
+	}
+
+	var startAcc math.LegacyDec
+	if first, found := k.latestTwapRecord(ctx, marketId, outcomeIndex, start); found {
+		startAcc = accumulatorAt(first, start)
+	} else {
+		oldest, err := k.oldestTwapRecord(ctx, marketId, outcomeIndex)
+		if err != nil {
+			return math.LegacyDec{}, 0, err
+		}
+		start = oldest.Timestamp
+		startAcc = parsePrice(oldest.CumulativePrice)
+	}
+	if start >= end {
+		return parsePrice(last.Price), start, nil
+	}
+
+	endAcc := accumulatorAt(last, end)
+	return endAcc.Sub(startAcc).QuoInt64(end - start), start, nil
+}
+
+// accumulatorAt extrapolates a record's cumulative price to time t, assuming
+// its price stayed in effect since the record was taken.
+func accumulatorAt(record types.TwapRecord, t int64) math.LegacyDec {
+	elapsed := t - record.Timestamp
+	return parsePrice(record.CumulativePrice).Add(parsePrice(record.Price).MulInt64(elapsed))
+}
+
+// latestTwapRecord returns the most recent record taken at or before t
+func (k Keeper) latestTwapRecord(ctx context.Context, marketId uint64, outcomeIndex uint32, t int64) (types.TwapRecord, bool) {
+	rng := new(collections.Range[collections.Triple[uint64, uint32, int64]]).
+		StartInclusive(collections.TripleSuperPrefix[uint64, uint32, int64](marketId, outcomeIndex)).
+		EndInclusive(collections.Join3(marketId, outcomeIndex, t)).
+		Descending()
+	iter, err := k.TwapRecords.Iterate(ctx, rng)
+	if err != nil {
+		return types.TwapRecord{}, false
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.TwapRecord{}, false
+	}
+	record, err := iter.Value()
+	if err != nil {
+		return types.TwapRecord{}, false
+	}
+	return record, true
+}
+
+// oldestTwapRecord returns the first record kept for an outcome
+func (k Keeper) oldestTwapRecord(ctx context.Context, marketId uint64, outcomeIndex uint32) (types.TwapRecord, error) {
+	rng := collections.NewSuperPrefixedTripleRange[uint64, uint32, int64](marketId, outcomeIndex)
+	iter, err := k.TwapRecords.Iterate(ctx, rng)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.TwapRecord{}, errors.Wrapf(types.ErrTwapUnavailable, "no price history for market %d outcome %d", marketId, outcomeIndex)
+	}
+	return iter.Value()
+}
+
+// pruneTwapRecords deletes records older than cutoff, keeping the newest of
+// them so windows starting at the cutoff can still be interpolated.
+func (k Keeper) pruneTwapRecords(ctx context.Context, marketId uint64, outcomeIndex uint32, cutoff int64) error {
+	rng := collections.NewSuperPrefixedTripleRange[uint64, uint32, int64](marketId, outcomeIndex)
+	iter, err := k.TwapRecords.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	var stale []collections.Triple[uint64, uint32, int64]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if key.K3() >= cutoff {
+			break
+		}
+		stale = append(stale, key)
+	}
+	iter.Close()
+
+	if len(stale) < 2 {
+		return nil
+	}
+	for _, key := range stale[:len(stale)-1] {
+		if err := k.TwapRecords.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
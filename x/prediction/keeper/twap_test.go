@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func TestTWAP(t *testing.T) {
+	f := initFixture(t)
+	base := sdk.UnwrapSDKContext(f.ctx)
+	at := func(sec int64) sdk.Context { return base.WithBlockTime(time.Unix(sec, 0)) }
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	created, err := ms.CreateMarket(at(0), &types.MsgCreateMarket{
+		Creator:  "creator",
+		Question: "Will it rain?",
+		Outcomes: []string{"Yes", "No"},
+		Deadline: 1_000_000,
+	})
+	require.NoError(t, err)
+
+	trade := func(ctx sdk.Context, price string) {
+		coin := sdk.NewInt64Coin("stake", 1)
+		for _, side := range []string{"SELL", "BUY"} {
+			_, err := ms.PostOrder(ctx, &types.MsgPostOrder{
+				Creator:  "trader",
+				MarketId: created.MarketId,
+				Side:     side,
+				Price:    price,
+				Amount:   &coin,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	// No history yet
+	_, _, err = f.keeper.TWAP(at(0), created.MarketId, 0, time.Minute)
+	require.ErrorIs(t, err, types.ErrTwapUnavailable)
+
+	trade(at(100), "0.4")
+	require.NoError(t, f.keeper.UpdateTwapAccumulators(at(110)))
+	trade(at(150), "0.6")
+	require.NoError(t, f.keeper.UpdateTwapAccumulators(at(210)))
+	// Blocks without trades, or trading at the recorded price, record nothing
+	require.NoError(t, f.keeper.UpdateTwapAccumulators(at(250)))
+	trade(at(250), "0.6")
+	require.NoError(t, f.keeper.UpdateTwapAccumulators(at(260)))
+	// A spike in the current block is not visible until the next accumulator update
+	trade(at(310), "0.99")
+
+	twap, start, err := f.keeper.TWAP(at(310), created.MarketId, 0, 200*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, int64(110), start)
+	require.Equal(t, "0.500000000000000000", twap.String())
+
+	// Windows reaching before the first record start at the oldest record
+	twap, start, err = f.keeper.TWAP(at(310), created.MarketId, 0, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, int64(110), start)
+	require.Equal(t, "0.500000000000000000", twap.String())
+
+	records := 0
+	require.NoError(t, f.keeper.TwapRecords.Walk(base, nil, func(_ collections.Triple[uint64, uint32, int64], _ types.TwapRecord) (bool, error) {
+		records++
+		return false, nil
+	}))
+	require.Equal(t, 2, records)
+
+	res, err := qs.TWAP(at(310), &types.QueryTWAPRequest{MarketId: created.MarketId, WindowSeconds: 50})
+	require.NoError(t, err)
+	require.Equal(t, "0.600000000000000000", res.Twap)
+	require.Equal(t, int64(260), res.StartTime)
+	require.Equal(t, int64(310), res.EndTime)
+
+	_, err = qs.TWAP(at(310), &types.QueryTWAPRequest{MarketId: created.MarketId, WindowSeconds: 0})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.TWAP(at(310), &types.QueryTWAPRequest{MarketId: created.MarketId, OutcomeIndex: 1, WindowSeconds: 50})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTWAPPrunesOldRecords(t *testing.T) {
+	f := initFixture(t)
+	base := sdk.UnwrapSDKContext(f.ctx)
+	retention := int64(keeper.TwapRetention.Seconds())
+
+	for i, ts := range []int64{0, 10, 20, retention + 15, retention + 30} {
+		price := []string{"0.5", "0.6"}[i%2]
+		f.keeper.SetTrade(base, types.Trade{TradeId: uint64(i), MarketId: 0, OutcomeIndex: 0, Price: price})
+		require.NoError(t, f.keeper.UpdateTwapAccumulators(base.WithBlockTime(time.Unix(ts, 0))))
+	}
+
+	var timestamps []int64
+	require.NoError(t, f.keeper.TwapRecords.Walk(base, nil, func(_ collections.Triple[uint64, uint32, int64], record types.TwapRecord) (bool, error) {
+		timestamps = append(timestamps, record.Timestamp)
+		return false, nil
+	}))
+	// Records before the cutoff are dropped, except the newest of them
+	require.Equal(t, []int64{20, retention + 15, retention + 30}, timestamps)
+}
@@ -0,0 +1,139 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"speculod/x/prediction/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. Positions
+// were stored under "marketId/owner/outcomeIndex" string keys, which sort
+// market 10 before market 2 and cannot be range scanned by market; v2 stores
+// them under (marketId, owner, outcomeIndex) triples. v2 also indexes open
+// markets by deadline, resting orders and trades by market and outcome.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	legacy := collections.NewMap(sb, types.PositionsKey, "positions", collections.StringKey, codec.CollValue[types.Position](cdc))
+	positions := collections.NewMap(sb, types.PositionsKey, "positions",
+		collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Uint32Key), codec.CollValue[types.Position](cdc))
+
+	// Both maps share a prefix, so collect the legacy entries before rewriting
+	kvs, err := legacy.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	entries, err := kvs.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range entries {
+		outcomeIndex, err := legacyOutcomeIndex(kv.Key)
+		if err != nil {
+			return err
+		}
+		if err := legacy.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+		if err := positions.Set(ctx, collections.Join3(kv.Value.MarketId, kv.Value.Owner, outcomeIndex), kv.Value); err != nil {
+			return err
+		}
+	}
+	if err := indexMarkets(ctx, sb, cdc); err != nil {
+		return err
+	}
+	if err := indexOrders(ctx, sb, cdc); err != nil {
+		return err
+	}
+	return indexTrades(ctx, sb, cdc)
+}
+
+// indexMarkets adds every open market to the index of open markets by deadline
+func indexMarkets(ctx context.Context, sb *collections.SchemaBuilder, cdc codec.BinaryCodec) error {
+	markets := collections.NewMap(sb, collections.NewPrefix("markets"), "markets", collections.Uint64Key, codec.CollValue[types.PredictionMarket](cdc))
+	index := collections.NewKeySet(sb, types.OpenMarketsByDeadlineKey, "open_markets_by_deadline",
+		collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key))
+	iter, err := markets.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	entries, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range entries {
+		if kv.Value.Status != types.MarketStatusOpen {
+			continue
+		}
+		if err := index.Set(ctx, collections.Join(kv.Value.Deadline, kv.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexOrders adds every open or partially filled order to the index of
+// resting orders by market and outcome
+func indexOrders(ctx context.Context, sb *collections.SchemaBuilder, cdc codec.BinaryCodec) error {
+	orders := collections.NewMap(sb, collections.NewPrefix("orders"), "orders", collections.Uint64Key, codec.CollValue[types.Order](cdc))
+	index := collections.NewKeySet(sb, types.RestingOrdersKey, "resting_orders",
+		collections.TripleKeyCodec(collections.Uint64Key, collections.Uint32Key, collections.Uint64Key))
+	iter, err := orders.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	entries, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range entries {
+		if kv.Value.Status != types.ORDER_STATUS_OPEN && kv.Value.Status != types.ORDER_STATUS_PARTIALLY_FILLED {
+			continue
+		}
+		if err := index.Set(ctx, collections.Join3(kv.Value.MarketId, kv.Value.OutcomeIndex, kv.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexTrades adds every stored trade to the index of trades by market and outcome
+func indexTrades(ctx context.Context, sb *collections.SchemaBuilder, cdc codec.BinaryCodec) error {
+	trades := collections.NewMap(sb, collections.NewPrefix("trades"), "trades", collections.Uint64Key, codec.CollValue[types.Trade](cdc))
+	index := collections.NewKeySet(sb, types.TradesByOutcomeKey, "trades_by_outcome",
+		collections.TripleKeyCodec(collections.Uint64Key, collections.Uint32Key, collections.Uint64Key))
+	iter, err := trades.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	entries, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range entries {
+		if err := index.Set(ctx, collections.Join3(kv.Value.MarketId, kv.Value.OutcomeIndex, kv.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// legacyOutcomeIndex returns the outcome index of a v1 position key
+func legacyOutcomeIndex(key string) (uint32, error) {
+	idx := strings.LastIndex(key, "/")
+	if idx < 0 {
+		return 0, fmt.Errorf("invalid position key %q", key)
+	}
+	outcomeIndex, err := strconv.ParseUint(key[idx+1:], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid position key %q: %w", key, err)
+	}
+	return uint32(outcomeIndex), nil
+}
@@ -0,0 +1,105 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	v2 "speculod/x/prediction/migrations/v2"
+	module "speculod/x/prediction/module"
+	"speculod/x/prediction/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	coin := sdk.NewInt64Coin("stake", 5)
+
+	// v1 stored positions under "marketId/owner/outcomeIndex" string keys
+	positions := map[string]types.Position{
+		"2/alice/0":  {MarketId: 2, Owner: "alice", Probability: "0.4", IsBuy: true, Amount: &coin},
+		"2/alice/1":  {MarketId: 2, Owner: "alice", Probability: "0.6", IsBuy: true, Amount: &coin},
+		"10/alice/1": {MarketId: 10, Owner: "alice", Probability: "0.1", Amount: &coin},
+	}
+	store := ctx.KVStore(storeKey)
+	for key, pos := range positions {
+		store.Set(append(types.PositionsKey.Bytes(), key...), cdc.MustMarshal(&pos))
+	}
+
+	// v1 stored trades without an index by market and outcome
+	trades := collections.NewMap(collections.NewSchemaBuilder(storeService), collections.NewPrefix("trades"), "trades",
+		collections.Uint64Key, codec.CollValue[types.Trade](cdc))
+	require.NoError(t, trades.Set(ctx, 0, types.Trade{TradeId: 0, MarketId: 10, OutcomeIndex: 1, Price: "0.1", Amount: &coin}))
+	require.NoError(t, trades.Set(ctx, 1, types.Trade{TradeId: 1, MarketId: 2, OutcomeIndex: 1, Price: "0.6", Amount: &coin}))
+	markets := collections.NewMap(collections.NewSchemaBuilder(storeService), collections.NewPrefix("markets"), "markets",
+		collections.Uint64Key, codec.CollValue[types.PredictionMarket](cdc))
+	require.NoError(t, markets.Set(ctx, 2, types.PredictionMarket{Id: 2, Deadline: 100, Status: types.MarketStatusOpen}))
+	require.NoError(t, markets.Set(ctx, 10, types.PredictionMarket{Id: 10, Deadline: 50, Status: types.MarketStatusClosed}))
+	orders := collections.NewMap(collections.NewSchemaBuilder(storeService), collections.NewPrefix("orders"), "orders",
+		collections.Uint64Key, codec.CollValue[types.Order](cdc))
+	require.NoError(t, orders.Set(ctx, 0, types.Order{Id: 0, MarketId: 2, Status: types.ORDER_STATUS_PARTIALLY_FILLED, Amount: &coin}))
+	require.NoError(t, orders.Set(ctx, 1, types.Order{Id: 1, MarketId: 2, Status: types.ORDER_STATUS_FILLED, Amount: &coin}))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	k := keeper.NewKeeper(storeService, cdc, addressCodec, authtypes.NewModuleAddress(types.GovModuleName), nil, nil, nil)
+	pos, found := k.GetPosition(ctx, 2, "alice", 1)
+	require.True(t, found)
+	require.Equal(t, positions["2/alice/1"], pos)
+	pos, found = k.GetPosition(ctx, 10, "alice", 1)
+	require.True(t, found)
+	require.Equal(t, positions["10/alice/1"], pos)
+	_, found = k.GetPosition(ctx, 10, "alice", 0)
+	require.False(t, found)
+
+	// No legacy key is left behind
+	for key := range positions {
+		require.False(t, store.Has(append(types.PositionsKey.Bytes(), key...)))
+	}
+	count := 0
+	require.NoError(t, k.Positions.Walk(ctx, nil, func(_ collections.Triple[uint64, string, uint32], _ types.Position) (bool, error) {
+		count++
+		return false, nil
+	}))
+	require.Len(t, positions, count)
+
+	indexed := k.GetTradesByMarketAndOutcome(ctx, 10, 1)
+	require.Len(t, indexed, 1)
+	require.Equal(t, uint64(0), indexed[0].TradeId)
+	require.Empty(t, k.GetTradesByMarketAndOutcome(ctx, 10, 0))
+
+	has, err := k.OpenMarketsByDeadline.Has(ctx, collections.Join(int64(100), uint64(2)))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = k.OpenMarketsByDeadline.Has(ctx, collections.Join(int64(50), uint64(10)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	resting := k.GetRestingOrdersByMarketAndOutcome(ctx, 2, 0)
+	require.Len(t, resting, 1)
+	require.Equal(t, uint64(0), resting[0].Id)
+}
+
+func TestMigrateStoreInvalidKey(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	pos := types.Position{MarketId: 1, Owner: "alice"}
+	ctx.KVStore(storeKey).Set(append(types.PositionsKey.Bytes(), "1/alice"...), cdc.MustMarshal(&pos))
+	require.Error(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+}
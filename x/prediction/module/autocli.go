@@ -17,6 +17,116 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "Markets",
+					Use:       "markets",
+					Short:     "List all markets",
+				},
+				{
+					RpcMethod:      "Market",
+					Use:            "market [market-id]",
+					Short:          "Show a market",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}},
+				},
+				{
+					RpcMethod:      "Orders",
+					Use:            "orders [market-id] [outcome-index]",
+					Short:          "List the orders of a market outcome",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "outcome_index"}},
+				},
+				{
+					RpcMethod:      "Order",
+					Use:            "order [order-id]",
+					Short:          "Show an order",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "order_id"}},
+				},
+				{
+					RpcMethod:      "OrderBook",
+					Use:            "order-book [market-id] [outcome-index]",
+					Short:          "Show the order book of a market outcome",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "outcome_index"}},
+				},
+				{
+					RpcMethod:      "UserOrders",
+					Use:            "user-orders [user]",
+					Short:          "List the orders of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "user"}},
+				},
+				{
+					RpcMethod:      "Depth",
+					Use:            "depth [market-id] [outcome-index]",
+					Short:          "Show the aggregated depth of a market outcome",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "outcome_index"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"levels": {Usage: "number of price levels per side, 0 for all"},
+					},
+				},
+				{
+					RpcMethod: "SimulateOrder",
+					Use:       "simulate-order [creator] [market-id] [outcome-index] [side] [price] [amount]",
+					Short:     "Simulate matching an order against the book without posting it",
+					Example:   "simulate-order cosmos1... 1 0 BUY 0.55 100stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "creator"},
+						{ProtoField: "market_id"},
+						{ProtoField: "outcome_index"},
+						{ProtoField: "side"},
+						{ProtoField: "price"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod:      "MarketPrices",
+					Use:            "market-prices [market-id]",
+					Short:          "Show the prices and implied probabilities of every outcome of a market",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}},
+				},
+				{
+					RpcMethod:      "TWAP",
+					Use:            "twap [market-id] [outcome-index] [window-seconds]",
+					Short:          "Show the time-weighted average price of a market outcome",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "outcome_index"}, {ProtoField: "window_seconds"}},
+				},
+				{
+					RpcMethod:      "Group",
+					Use:            "group [id]",
+					Short:          "Show a market group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Groups",
+					Use:       "groups",
+					Short:     "List all market groups",
+				},
+				{
+					RpcMethod:      "GroupMembers",
+					Use:            "group-members [id]",
+					Short:          "List the members of a market group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "MarketTemplate",
+					Use:            "market-template [template-id]",
+					Short:          "Show a market template",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "template_id"}},
+				},
+				{
+					RpcMethod: "MarketTemplates",
+					Use:       "market-templates",
+					Short:     "List all market templates",
+				},
+				{
+					RpcMethod:      "Positions",
+					Use:            "positions [market-id] [owner]",
+					Short:          "List the outcome share positions in a market, optionally of one owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "owner", Optional: true}},
+				},
+				{
+					RpcMethod:      "Exposure",
+					Use:            "exposure [market-id] [outcome-index] [account]",
+					Short:          "Show the net position and resting orders of an account on a market outcome",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "outcome_index"}, {ProtoField: "account"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +138,95 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "SetMarketLimits",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CreateMarket",
+					Use:       "create-market [question] [deadline] [outcomes...]",
+					Short:     "Create a prediction market closing at a unix deadline",
+					Example:   "create-market \"Will it rain tomorrow?\" 1735689600 Yes No --group-id weather",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "question"},
+						{ProtoField: "deadline"},
+						{ProtoField: "outcomes", Varargs: true},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"group_id":     {Usage: "market group the market belongs to"},
+						"initial_pool": {Usage: "initial liquidity pool, e.g. 100stake"},
+					},
+				},
+				{
+					RpcMethod: "PostOrder",
+					Use:       "post-order [market-id] [outcome-index] [side] [price] [amount]",
+					Short:     "Post a BUY or SELL order on a market outcome",
+					Example:   "post-order 1 0 BUY 0.55 100stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "market_id"},
+						{ProtoField: "outcome_index"},
+						{ProtoField: "side"},
+						{ProtoField: "price"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod:      "CancelOrder",
+					Use:            "cancel-order [order-id]",
+					Short:          "Cancel an open order",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "order_id"}},
+				},
+				{
+					RpcMethod:      "FillOrder",
+					Use:            "fill-order [order-id] [amount]",
+					Short:          "Fill a resting order",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "order_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "RegisterGroup",
+					Use:            "register-group [id] [cosmos-group-id] [name]",
+					Short:          "Register a market group backed by an x/group group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "cosmos_group_id"}, {ProtoField: "name"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"description": {Usage: "description of the group"},
+						"admins":      {Usage: "comma separated list of group admins"},
+					},
+				},
+				{
+					RpcMethod:      "UpdateGroup",
+					Use:            "update-group [id] [name]",
+					Short:          "Update the name, description and admins of a market group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "name"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"description": {Usage: "description of the group"},
+						"admins":      {Usage: "comma separated list of group admins"},
+					},
+				},
+				{
+					RpcMethod: "CreateMarketTemplate",
+					Use:       "create-market-template [question] [duration] [epoch-identifier] [outcomes...]",
+					Short:     "Create a template that opens a market at the end of every epoch",
+					Long:      "Create a template that opens a market at the end of every epoch. The question may contain the {epoch} and {date} placeholders.",
+					Example:   "create-market-template \"Will ETH close above $4000 in week {epoch}?\" 86400 week Yes No --bond 10stake --roll-bond",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "question"},
+						{ProtoField: "duration"},
+						{ProtoField: "epoch_identifier"},
+						{ProtoField: "outcomes", Varargs: true},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"group_id":   {Usage: "market group of the created markets"},
+						"recurrence": {Usage: "number of epochs between markets"},
+						"bond":       {Usage: "bond escrowed for every created market"},
+						"roll_bond":  {Usage: "move the bond of an unresolved market to the next one"},
+					},
+				},
+				{
+					RpcMethod:      "SetMarketTemplateActive",
+					Use:            "set-market-template-active [template-id] [active]",
+					Short:          "Pause or resume a market template",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "template_id"}, {ProtoField: "active"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -1,6 +1,9 @@
 package prediction
 
 import (
+	"maps"
+	"slices"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -9,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
 
 	"speculod/x/prediction/keeper"
 	"speculod/x/prediction/types"
@@ -23,6 +27,7 @@ func init() {
 	appconfig.Register(
 		&types.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetPredictionHooks),
 	)
 }
 
@@ -34,8 +39,12 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper bankkeeper.Keeper
+	AuthKeeper  types.AuthKeeper
+	BankKeeper  bankkeeper.Keeper
+	GroupKeeper types.GroupKeeper
+	// The epochs module outputs its keeper by value while its methods have
+	// pointer receivers.
+	EpochsKeeper epochskeeper.Keeper
 }
 
 type ModuleOutputs struct {
@@ -57,8 +66,26 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 		authority,
 		in.BankKeeper,
+		in.GroupKeeper,
+		&in.EpochsKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
 	return ModuleOutputs{PredictionKeeper: k, Module: m}
 }
+
+// InvokeSetPredictionHooks sets the hooks provided by other modules on the
+// prediction keeper, ordered by module name.
+func InvokeSetPredictionHooks(k keeper.Keeper, predictionHooks map[string]types.PredictionHooksWrapper) error {
+	if len(predictionHooks) == 0 {
+		return nil
+	}
+
+	var multiHooks types.MultiPredictionHooks
+	for _, modName := range slices.Sorted(maps.Keys(predictionHooks)) {
+		multiHooks = append(multiHooks, predictionHooks[modName])
+	}
+
+	k.SetHooks(multiHooks)
+	return nil
+}
@@ -1,12 +1,50 @@
 package prediction
 
 import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	predictionsimulation "speculod/x/prediction/simulation"
 	"speculod/x/prediction/types"
 )
 
+const (
+	opWeightMsgCreateMarket          = "op_weight_msg_create_market"
+	defaultWeightMsgCreateMarket int = 20
+
+	opWeightMsgPostOrder          = "op_weight_msg_post_order"
+	defaultWeightMsgPostOrder int = 100
+
+	opWeightMsgCancelOrder          = "op_weight_msg_cancel_order"
+	defaultWeightMsgCancelOrder int = 20
+
+	opWeightMsgFillOrder          = "op_weight_msg_fill_order"
+	defaultWeightMsgFillOrder int = 50
+
+	opWeightMsgRegisterGroup          = "op_weight_msg_register_group"
+	defaultWeightMsgRegisterGroup int = 5
+
+	opWeightMsgUpdateGroup          = "op_weight_msg_update_group"
+	defaultWeightMsgUpdateGroup int = 5
+
+	opWeightMsgCreateMarketTemplate          = "op_weight_msg_create_market_template"
+	defaultWeightMsgCreateMarketTemplate int = 5
+
+	opWeightMsgSetMarketTemplateActive          = "op_weight_msg_set_market_template_active"
+	defaultWeightMsgSetMarketTemplateActive int = 5
+
+	opWeightMsgSetMarketLimits          = "op_weight_msg_set_market_limits"
+	defaultWeightMsgSetMarketLimits int = 20
+)
+
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	accs := make([]string, len(simState.Accounts))
@@ -16,19 +54,178 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	predictionGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
 	}
+
+	// Some markets expire during the simulation so they can be settled
+	numMarkets := simState.Rand.Intn(10)
+	for i := 0; i < numMarkets; i++ {
+		duration := time.Duration(simtypes.RandIntBetween(simState.Rand, 1, 72)) * time.Hour
+		predictionGenesis.Markets = append(predictionGenesis.Markets, types.PredictionMarket{
+			Id:        uint64(i),
+			Question:  fmt.Sprintf("Simulated market %d?", i),
+			Outcomes:  predictionsimulation.RandomOutcomes(simState.Rand),
+			Deadline:  simState.GenTimestamp.Add(duration).Unix(),
+			Status:    types.MarketStatusOpen,
+			Creator:   accs[simState.Rand.Intn(len(accs))],
+			CreatedAt: simState.GenTimestamp.Unix(),
+		})
+	}
+	predictionGenesis.MarketIdSeq = uint64(numMarkets)
+
+	// The x/group and x/epochs genesis are generated first, so market groups
+	// can link their groups and templates can recur on their epochs
+	if raw, ok := simState.GenState[group.ModuleName]; ok {
+		var groupGenesis group.GenesisState
+		simState.Cdc.MustUnmarshalJSON(raw, &groupGenesis)
+		for _, info := range groupGenesis.Groups {
+			predictionGenesis.Groups = append(predictionGenesis.Groups, types.MarketGroup{
+				Id:            fmt.Sprintf("sim-group-%d", info.Id),
+				CosmosGroupId: info.Id,
+				Name:          fmt.Sprintf("Simulated group %d", info.Id),
+				Admins:        []string{info.Admin},
+				Creator:       info.Admin,
+				CreatedAt:     simState.GenTimestamp.Unix(),
+			})
+		}
+	}
+	if raw, ok := simState.GenState[epochstypes.ModuleName]; ok {
+		var epochsGenesis epochstypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(raw, &epochsGenesis)
+		for i, epoch := range epochsGenesis.Epochs {
+			if simState.Rand.Intn(2) == 0 {
+				continue
+			}
+			var bond *sdk.Coin
+			if limits := predictionGenesis.Params.TemplateLimits; limits.HasMinBond() {
+				bond = &limits.MinBond
+			}
+			predictionGenesis.Templates = append(predictionGenesis.Templates, types.MarketTemplate{
+				Id:              uint64(i),
+				Creator:         accs[simState.Rand.Intn(len(accs))],
+				Question:        "Simulated template market of epoch {epoch}?",
+				Outcomes:        predictionsimulation.RandomOutcomes(simState.Rand),
+				Duration:        int64(simtypes.RandIntBetween(simState.Rand, 1, 72)) * int64(time.Hour/time.Second),
+				EpochIdentifier: epoch.Identifier,
+				Recurrence:      int64(simtypes.RandIntBetween(simState.Rand, 1, 4)),
+				Bond:            bond,
+				RollBond:        simState.Rand.Intn(2) == 0,
+				Active:          true,
+				CreatedAt:       simState.GenTimestamp.Unix(),
+			})
+		}
+		predictionGenesis.TemplateIdSeq = uint64(len(epochsGenesis.Epochs))
+	}
+
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&predictionGenesis)
 }
 
-// RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+// RegisterStoreDecoder registers a decoder that pretty-prints the values of
+// the module's collections by key prefix.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
+
+	var weightMsgCreateMarket int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateMarket, &weightMsgCreateMarket, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateMarket = defaultWeightMsgCreateMarket
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateMarket,
+		predictionsimulation.SimulateMsgCreateMarket(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgPostOrder int
+	simState.AppParams.GetOrGenerate(opWeightMsgPostOrder, &weightMsgPostOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgPostOrder = defaultWeightMsgPostOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPostOrder,
+		predictionsimulation.SimulateMsgPostOrder(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCancelOrder int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelOrder, &weightMsgCancelOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelOrder = defaultWeightMsgCancelOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelOrder,
+		predictionsimulation.SimulateMsgCancelOrder(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgFillOrder int
+	simState.AppParams.GetOrGenerate(opWeightMsgFillOrder, &weightMsgFillOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgFillOrder = defaultWeightMsgFillOrder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFillOrder,
+		predictionsimulation.SimulateMsgFillOrder(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgRegisterGroup int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterGroup, &weightMsgRegisterGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterGroup = defaultWeightMsgRegisterGroup
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterGroup,
+		predictionsimulation.SimulateMsgRegisterGroup(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUpdateGroup int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateGroup, &weightMsgUpdateGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateGroup = defaultWeightMsgUpdateGroup
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateGroup,
+		predictionsimulation.SimulateMsgUpdateGroup(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCreateMarketTemplate int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateMarketTemplate, &weightMsgCreateMarketTemplate, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateMarketTemplate = defaultWeightMsgCreateMarketTemplate
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateMarketTemplate,
+		predictionsimulation.SimulateMsgCreateMarketTemplate(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgSetMarketTemplateActive int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetMarketTemplateActive, &weightMsgSetMarketTemplateActive, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetMarketTemplateActive = defaultWeightMsgSetMarketTemplateActive
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetMarketTemplateActive,
+		predictionsimulation.SimulateMsgSetMarketTemplateActive(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgSetMarketLimits,
+			defaultWeightMsgSetMarketLimits,
+			predictionsimulation.SimulateMsgSetMarketLimits(am.keeper),
+		),
+	}
 }
@@ -0,0 +1,66 @@
+package prediction_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/keeper"
+	prediction "speculod/x/prediction/module"
+	"speculod/x/prediction/types"
+)
+
+func TestStoreDecoder(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(prediction.AppModule{}).Codec
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storetypes.NewKVStoreKey(types.StoreKey)),
+		cdc,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil, nil, nil,
+	)
+	sdr := make(simtypes.StoreDecoderRegistry)
+	prediction.NewAppModule(cdc, k, nil, nil).RegisterStoreDecoder(sdr)
+	dec := sdr[types.StoreKey]
+
+	amount := sdk.NewInt64Coin("stake", 10)
+	market := types.PredictionMarket{Id: 1, Question: "Will it rain?", Outcomes: []string{"Yes", "No"}, Status: types.MarketStatusOpen}
+	order := types.Order{Id: 2, MarketId: 1, Creator: "alice", Side: types.ORDER_SIDE_BUY, Price: "0.4", Amount: &amount}
+	position := types.Position{MarketId: 1, Owner: "alice", Probability: "0.4", IsBuy: true, Amount: &amount}
+
+	marketKey, err := collections.EncodeKeyWithPrefix(k.Markets.GetPrefix(), k.Markets.KeyCodec(), 1)
+	require.NoError(t, err)
+	orderKey, err := collections.EncodeKeyWithPrefix(k.Orders.GetPrefix(), k.Orders.KeyCodec(), 2)
+	require.NoError(t, err)
+	positionKey, err := collections.EncodeKeyWithPrefix(k.Positions.GetPrefix(), k.Positions.KeyCodec(), collections.Join3(uint64(1), "alice", uint32(0)))
+	require.NoError(t, err)
+	priceKey, err := collections.EncodeKeyWithPrefix(k.LastTradePrices.GetPrefix(), k.LastTradePrices.KeyCodec(), collections.Join(uint64(1), uint32(0)))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		kvA, kvB    kv.Pair
+		expectedLog string
+	}{
+		{"market", kv.Pair{Key: marketKey, Value: cdc.MustMarshal(&market)}, kv.Pair{Key: marketKey, Value: cdc.MustMarshal(&market)}, market.String() + "\n" + market.String()},
+		{"order", kv.Pair{Key: orderKey, Value: cdc.MustMarshal(&order)}, kv.Pair{Key: orderKey, Value: cdc.MustMarshal(&types.Order{})}, order.String() + "\n"},
+		{"position", kv.Pair{Key: positionKey, Value: cdc.MustMarshal(&position)}, kv.Pair{Key: positionKey, Value: cdc.MustMarshal(&position)}, position.String() + "\n" + position.String()},
+		{"last trade price", kv.Pair{Key: priceKey, Value: []byte("0.4")}, kv.Pair{Key: priceKey, Value: []byte("0.5")}, "0.4\n0.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLog, dec(tt.kvA, tt.kvB))
+		})
+	}
+
+	require.Panics(t, func() { dec(kv.Pair{Key: []byte("unknown")}, kv.Pair{Key: []byte("unknown")}) })
+}
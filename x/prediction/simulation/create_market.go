@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// maxMarketDuration bounds the lifetime of simulated markets. Simulated blocks
+// are minutes to hours apart, so markets stay open for a few dozen blocks.
+const maxMarketDuration = 48 * time.Hour
+
+func SimulateMsgCreateMarket(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		duration := time.Duration(simtypes.RandIntBetween(r, int(time.Hour/time.Second), int(maxMarketDuration/time.Second))) * time.Second
+		msg := &types.MsgCreateMarket{
+			Creator:  simAccount.Address.String(),
+			Question: simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 10, 100)),
+			Outcomes: RandomOutcomes(r),
+			Deadline: ctx.BlockTime().Add(duration).Unix(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
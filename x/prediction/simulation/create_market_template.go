@@ -0,0 +1,73 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// SimulateMsgCreateMarketTemplate creates a template recurring on the epoch
+// of an existing template, bonded with the minimum bond
+func SimulateMsgCreateMarketTemplate(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCreateMarketTemplate{}
+
+		// Templates only recur on existing epochs, which are known through
+		// the templates of the genesis
+		existing, found := randomTemplate(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no template epoch to recur on"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg.Creator = simAccount.Address.String()
+		msg.Question = simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 10, 100)) + " {epoch}?"
+		msg.Outcomes = RandomOutcomes(r)
+		msg.Duration = int64(simtypes.RandIntBetween(r, int(time.Hour/time.Second), int(maxMarketDuration/time.Second)))
+		msg.EpochIdentifier = existing.EpochIdentifier
+		msg.Recurrence = int64(simtypes.RandIntBetween(r, 1, 4))
+		msg.RollBond = r.Intn(2) == 0
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get params"), nil, err
+		}
+		spent := sdk.NewCoins()
+		if params.TemplateLimits.HasMinBond() {
+			bond := params.TemplateLimits.MinBond
+			msg.Bond = &bond
+			spent = sdk.NewCoins(bond)
+		}
+		if err := k.CheckActiveTemplates(ctx, msg.Creator); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "too many active templates"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spent,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
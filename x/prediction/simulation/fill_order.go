@@ -0,0 +1,64 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func SimulateMsgFillOrder(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgFillOrder{
+			Filler: simAccount.Address.String(),
+		}
+
+		order, _, found := randomRestingOrder(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no resting order"), nil, nil
+		}
+		remaining := order.Amount.Amount.Sub(order.FilledAmount.Amount)
+		fill, err := simtypes.RandPositiveInt(r, remaining)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "order has nothing left to fill"), nil, nil
+		}
+		amount := sdk.NewCoin(order.Amount.Denom, fill)
+		msg.OrderId = order.Id
+		msg.Amount = &amount
+
+		market, _ := k.GetPredictionMarket(ctx, order.MarketId)
+		price := math.LegacyMustNewDecFromStr(order.Price)
+		if err := k.CheckPositionLimits(ctx, market, msg.Filler, order.OutcomeIndex, order.Side.Opposite(), fill, price, false); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "position limits exceeded"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
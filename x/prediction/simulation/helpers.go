@@ -0,0 +1,178 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// RandomOutcomes returns two to four distinct market outcomes
+func RandomOutcomes(r *rand.Rand) []string {
+	n := simtypes.RandIntBetween(r, 2, 5)
+	if n == 2 {
+		return []string{"Yes", "No"}
+	}
+	outcomes := make([]string, n)
+	for i := range outcomes {
+		outcomes[i] = fmt.Sprintf("Outcome %d", i+1)
+	}
+	return outcomes
+}
+
+// RandomPrice returns a probability between 0.01 and 0.99
+func RandomPrice(r *rand.Rand) string {
+	return math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2).String()
+}
+
+// randomAmount returns between 1 and 1000 units of the bond denom
+func randomAmount(r *rand.Rand) sdk.Coin {
+	return sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 1001)))
+}
+
+// randomOpenMarket picks a market that still accepts orders
+func randomOpenMarket(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.PredictionMarket, bool) {
+	var open []types.PredictionMarket
+	if err := k.Markets.Walk(ctx, nil, func(_ uint64, market types.PredictionMarket) (bool, error) {
+		if market.Status == types.MarketStatusOpen && market.Deadline > ctx.BlockTime().Unix() {
+			open = append(open, market)
+		}
+		return false, nil
+	}); err != nil || len(open) == 0 {
+		return types.PredictionMarket{}, false
+	}
+	return open[r.Intn(len(open))], true
+}
+
+// randomRestingOrder picks an open or partially filled order of an open
+// market whose creator is one of the simulation accounts
+func randomRestingOrder(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.Order, simtypes.Account, bool) {
+	var (
+		orders   []types.Order
+		creators []simtypes.Account
+	)
+	for _, order := range k.GetAllOrders(ctx) {
+		if order.Status != types.ORDER_STATUS_OPEN && order.Status != types.ORDER_STATUS_PARTIALLY_FILLED {
+			continue
+		}
+		market, found := k.GetPredictionMarket(ctx, order.MarketId)
+		if !found || market.Status != types.MarketStatusOpen {
+			continue
+		}
+		creator, err := sdk.AccAddressFromBech32(order.Creator)
+		if err != nil {
+			continue
+		}
+		acc, found := simtypes.FindAccount(accs, creator)
+		if !found {
+			continue
+		}
+		orders = append(orders, order)
+		creators = append(creators, acc)
+	}
+	if len(orders) == 0 {
+		return types.Order{}, simtypes.Account{}, false
+	}
+	i := r.Intn(len(orders))
+	return orders[i], creators[i], true
+}
+
+// randomAdministeredGroupInfo picks an x/group group whose admin is one of the
+// simulation accounts. x/group assigns sequential ids, so groups are looked up
+// from id 1 until one is missing.
+func randomAdministeredGroupInfo(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (*group.GroupInfo, simtypes.Account, bool) {
+	var (
+		infos  []*group.GroupInfo
+		admins []simtypes.Account
+	)
+	for id := uint64(1); ; id++ {
+		info, err := k.GetGroupInfo(ctx, id)
+		if err != nil {
+			break
+		}
+		admin, err := sdk.AccAddressFromBech32(info.Admin)
+		if err != nil {
+			continue
+		}
+		acc, found := simtypes.FindAccount(accs, admin)
+		if !found {
+			continue
+		}
+		infos = append(infos, info)
+		admins = append(admins, acc)
+	}
+	if len(infos) == 0 {
+		return nil, simtypes.Account{}, false
+	}
+	i := r.Intn(len(infos))
+	return infos[i], admins[i], true
+}
+
+// randomAdministeredMarketGroup picks a market group with an admin among the
+// simulation accounts
+func randomAdministeredMarketGroup(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.MarketGroup, simtypes.Account, bool) {
+	var (
+		groups []types.MarketGroup
+		admins []simtypes.Account
+	)
+	for _, g := range k.GetAllMarketGroups(ctx) {
+		for _, admin := range g.Admins {
+			addr, err := sdk.AccAddressFromBech32(admin)
+			if err != nil {
+				continue
+			}
+			if acc, found := simtypes.FindAccount(accs, addr); found {
+				groups = append(groups, g)
+				admins = append(admins, acc)
+				break
+			}
+		}
+	}
+	if len(groups) == 0 {
+		return types.MarketGroup{}, simtypes.Account{}, false
+	}
+	i := r.Intn(len(groups))
+	return groups[i], admins[i], true
+}
+
+// randomTemplate picks a template of any creator
+func randomTemplate(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.MarketTemplate, bool) {
+	var templates []types.MarketTemplate
+	if err := k.Templates.Walk(ctx, nil, func(_ uint64, tmpl types.MarketTemplate) (bool, error) {
+		templates = append(templates, tmpl)
+		return false, nil
+	}); err != nil || len(templates) == 0 {
+		return types.MarketTemplate{}, false
+	}
+	return templates[r.Intn(len(templates))], true
+}
+
+// randomOwnedTemplate picks a template whose creator is one of the
+// simulation accounts
+func randomOwnedTemplate(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.MarketTemplate, simtypes.Account, bool) {
+	var (
+		templates []types.MarketTemplate
+		creators  []simtypes.Account
+	)
+	if err := k.Templates.Walk(ctx, nil, func(_ uint64, tmpl types.MarketTemplate) (bool, error) {
+		creator, err := sdk.AccAddressFromBech32(tmpl.Creator)
+		if err != nil {
+			return false, nil
+		}
+		if acc, found := simtypes.FindAccount(accs, creator); found {
+			templates = append(templates, tmpl)
+			creators = append(creators, acc)
+		}
+		return false, nil
+	}); err != nil || len(templates) == 0 {
+		return types.MarketTemplate{}, simtypes.Account{}, false
+	}
+	i := r.Intn(len(templates))
+	return templates[i], creators[i], true
+}
@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+func SimulateMsgPostOrder(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgPostOrder{
+			Creator: simAccount.Address.String(),
+		}
+
+		market, found := randomOpenMarket(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no open market"), nil, nil
+		}
+		amount := randomAmount(r)
+		msg.MarketId = market.Id
+		msg.OutcomeIndex = uint32(r.Intn(len(market.Outcomes)))
+		side := types.ORDER_SIDE_BUY
+		msg.Side = "BUY"
+		if r.Intn(2) == 0 {
+			side, msg.Side = types.ORDER_SIDE_SELL, "SELL"
+		}
+		msg.Price = RandomPrice(r)
+		msg.Amount = &amount
+
+		price := math.LegacyMustNewDecFromStr(msg.Price)
+		if err := k.CheckPositionLimits(ctx, market, msg.Creator, msg.OutcomeIndex, side, amount.Amount, price, true); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "position limits exceeded"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
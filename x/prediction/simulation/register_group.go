@@ -0,0 +1,56 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// SimulateMsgRegisterGroup links an x/group group administered by one of the
+// simulation accounts to a new market group
+func SimulateMsgRegisterGroup(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgRegisterGroup{}
+
+		info, simAccount, found := randomAdministeredGroupInfo(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no x/group group administered by a simulation account"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+		msg.Id = "sim-group-" + simtypes.RandStringOfLength(r, 8)
+		msg.CosmosGroupId = info.Id
+		msg.Name = simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 5, 30))
+		msg.Description = simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, 100))
+		if k.HasMarketGroup(ctx, msg.Id) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "group already registered"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
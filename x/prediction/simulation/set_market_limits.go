@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// SimulateMsgSetMarketLimits returns a MsgSetMarketLimits signed by the module
+// authority, to be submitted through a governance proposal. Half of the
+// proposals clear the limits of the market, the others set limits within the
+// module limits.
+func SimulateMsgSetMarketLimits(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		market, found := randomOpenMarket(r, ctx, k)
+		if !found {
+			return nil
+		}
+		msg := &types.MsgSetMarketLimits{
+			Authority: sdk.AccAddress(k.GetAuthority()).String(),
+			MarketId:  market.Id,
+		}
+		if r.Intn(2) == 0 {
+			return msg
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil
+		}
+		base := params.PositionLimits
+		limits := types.PositionLimits{
+			MaxPositionPerOutcome:   math.NewInt(int64(simtypes.RandIntBetween(r, 100, 10_000))),
+			MaxNotionalPerMarket:    math.LegacyNewDec(int64(simtypes.RandIntBetween(r, 100, 10_000))),
+			MaxOpenOrdersPerAccount: uint32(simtypes.RandIntBetween(r, 1, 20)),
+		}
+		if base.HasPositionLimit() {
+			limits.MaxPositionPerOutcome = math.MinInt(limits.MaxPositionPerOutcome, base.MaxPositionPerOutcome)
+		}
+		if base.HasNotionalLimit() {
+			limits.MaxNotionalPerMarket = math.LegacyMinDec(limits.MaxNotionalPerMarket, base.MaxNotionalPerMarket)
+		}
+		if base.MaxOpenOrdersPerAccount > 0 {
+			limits.MaxOpenOrdersPerAccount = min(limits.MaxOpenOrdersPerAccount, base.MaxOpenOrdersPerAccount)
+		}
+		msg.PositionLimits = &limits
+		return msg
+	}
+}
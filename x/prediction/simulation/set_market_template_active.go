@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// SimulateMsgSetMarketTemplateActive pauses an active template or resumes a
+// paused one, escrowing its bond again
+func SimulateMsgSetMarketTemplateActive(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetMarketTemplateActive{}
+
+		tmpl, simAccount, found := randomOwnedTemplate(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no template created by a simulation account"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+		msg.TemplateId = tmpl.Id
+		msg.Active = !tmpl.Active
+
+		spent := sdk.NewCoins()
+		if msg.Active {
+			if err := k.CheckActiveTemplates(ctx, tmpl.Creator); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "too many active templates"), nil, nil
+			}
+			params, err := k.Params.Get(ctx)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get params"), nil, err
+			}
+			if !params.TemplateLimits.CoversMinBond(tmpl.Bond) {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "bond below the minimum bond"), nil, nil
+			}
+			if tmpl.Bond != nil && tmpl.Bond.IsPositive() && !tmpl.BondEscrowed {
+				spent = sdk.NewCoins(*tmpl.Bond)
+			}
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spent,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
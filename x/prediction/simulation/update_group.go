@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/prediction/keeper"
+	"speculod/x/prediction/types"
+)
+
+// SimulateMsgUpdateGroup renames a market group and sometimes adds another
+// simulation account to its admins
+func SimulateMsgUpdateGroup(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUpdateGroup{}
+
+		g, simAccount, found := randomAdministeredMarketGroup(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no market group administered by a simulation account"), nil, nil
+		}
+		msg.Creator = simAccount.Address.String()
+		msg.Id = g.Id
+		msg.Name = simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 5, 30))
+		msg.Description = simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, 100))
+		msg.Admins = g.Admins
+		if r.Intn(2) == 0 {
+			admin, _ := simtypes.RandomAcc(r, accs)
+			if !keeper.IsGroupAdmin(g, admin.Address.String()) {
+				msg.Admins = append(append([]string{}, g.Admins...), admin.Address.String())
+			}
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,405 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: x/prediction/types/expected_keepers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=x/prediction/types/expected_keepers.go -package testutil -destination x/prediction/testutil/expected_keepers_mocks.go
+//
+
+// Package testutil is a generated GoMock package.
+package testutil
+
+import (
+	context "context"
+	reflect "reflect"
+	types1 "speculod/x/prediction/types"
+
+	address "cosmossdk.io/core/address"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	group "github.com/cosmos/cosmos-sdk/x/group"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthKeeper is a mock of AuthKeeper interface.
+type MockAuthKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthKeeperMockRecorder is the mock recorder for MockAuthKeeper.
+type MockAuthKeeperMockRecorder struct {
+	mock *MockAuthKeeper
+}
+
+// NewMockAuthKeeper creates a new mock instance.
+func NewMockAuthKeeper(ctrl *gomock.Controller) *MockAuthKeeper {
+	mock := &MockAuthKeeper{ctrl: ctrl}
+	mock.recorder = &MockAuthKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthKeeper) EXPECT() *MockAuthKeeperMockRecorder {
+	return m.recorder
+}
+
+// AddressCodec mocks base method.
+func (m *MockAuthKeeper) AddressCodec() address.Codec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressCodec")
+	ret0, _ := ret[0].(address.Codec)
+	return ret0
+}
+
+// AddressCodec indicates an expected call of AddressCodec.
+func (mr *MockAuthKeeperMockRecorder) AddressCodec() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressCodec", reflect.TypeOf((*MockAuthKeeper)(nil).AddressCodec))
+}
+
+// GetAccount mocks base method.
+func (m *MockAuthKeeper) GetAccount(arg0 context.Context, arg1 types.AccAddress) types.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(types.AccountI)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockAuthKeeperMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAuthKeeper)(nil).GetAccount), arg0, arg1)
+}
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
+// SpendableCoins mocks base method.
+func (m *MockBankKeeper) SpendableCoins(arg0 context.Context, arg1 types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoins", arg0, arg1)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// SpendableCoins indicates an expected call of SpendableCoins.
+func (mr *MockBankKeeperMockRecorder) SpendableCoins(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), arg0, arg1)
+}
+
+// MockGroupKeeper is a mock of GroupKeeper interface.
+type MockGroupKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupKeeperMockRecorder is the mock recorder for MockGroupKeeper.
+type MockGroupKeeperMockRecorder struct {
+	mock *MockGroupKeeper
+}
+
+// NewMockGroupKeeper creates a new mock instance.
+func NewMockGroupKeeper(ctrl *gomock.Controller) *MockGroupKeeper {
+	mock := &MockGroupKeeper{ctrl: ctrl}
+	mock.recorder = &MockGroupKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupKeeper) EXPECT() *MockGroupKeeperMockRecorder {
+	return m.recorder
+}
+
+// GroupInfo mocks base method.
+func (m *MockGroupKeeper) GroupInfo(arg0 context.Context, arg1 *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupInfo", arg0, arg1)
+	ret0, _ := ret[0].(*group.QueryGroupInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupInfo indicates an expected call of GroupInfo.
+func (mr *MockGroupKeeperMockRecorder) GroupInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupInfo", reflect.TypeOf((*MockGroupKeeper)(nil).GroupInfo), arg0, arg1)
+}
+
+// GroupMembers mocks base method.
+func (m *MockGroupKeeper) GroupMembers(arg0 context.Context, arg1 *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMembers", arg0, arg1)
+	ret0, _ := ret[0].(*group.QueryGroupMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupMembers indicates an expected call of GroupMembers.
+func (mr *MockGroupKeeperMockRecorder) GroupMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMembers", reflect.TypeOf((*MockGroupKeeper)(nil).GroupMembers), arg0, arg1)
+}
+
+// GroupPolicyInfo mocks base method.
+func (m *MockGroupKeeper) GroupPolicyInfo(arg0 context.Context, arg1 *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupPolicyInfo", arg0, arg1)
+	ret0, _ := ret[0].(*group.QueryGroupPolicyInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupPolicyInfo indicates an expected call of GroupPolicyInfo.
+func (mr *MockGroupKeeperMockRecorder) GroupPolicyInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupPolicyInfo", reflect.TypeOf((*MockGroupKeeper)(nil).GroupPolicyInfo), arg0, arg1)
+}
+
+// MockEpochsKeeper is a mock of EpochsKeeper interface.
+type MockEpochsKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochsKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockEpochsKeeperMockRecorder is the mock recorder for MockEpochsKeeper.
+type MockEpochsKeeperMockRecorder struct {
+	mock *MockEpochsKeeper
+}
+
+// NewMockEpochsKeeper creates a new mock instance.
+func NewMockEpochsKeeper(ctrl *gomock.Controller) *MockEpochsKeeper {
+	mock := &MockEpochsKeeper{ctrl: ctrl}
+	mock.recorder = &MockEpochsKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochsKeeper) EXPECT() *MockEpochsKeeperMockRecorder {
+	return m.recorder
+}
+
+// AllEpochInfos mocks base method.
+func (m *MockEpochsKeeper) AllEpochInfos(ctx types.Context) ([]types0.EpochInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllEpochInfos", ctx)
+	ret0, _ := ret[0].([]types0.EpochInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllEpochInfos indicates an expected call of AllEpochInfos.
+func (mr *MockEpochsKeeperMockRecorder) AllEpochInfos(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllEpochInfos", reflect.TypeOf((*MockEpochsKeeper)(nil).AllEpochInfos), ctx)
+}
+
+// GetEpochInfo mocks base method.
+func (m *MockEpochsKeeper) GetEpochInfo(ctx types.Context, identifier string) (types0.EpochInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochInfo", ctx, identifier)
+	ret0, _ := ret[0].(types0.EpochInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEpochInfo indicates an expected call of GetEpochInfo.
+func (mr *MockEpochsKeeperMockRecorder) GetEpochInfo(ctx, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochInfo", reflect.TypeOf((*MockEpochsKeeper)(nil).GetEpochInfo), ctx, identifier)
+}
+
+// MockPredictionHooks is a mock of PredictionHooks interface.
+type MockPredictionHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockPredictionHooksMockRecorder
+	isgomock struct{}
+}
+
+// MockPredictionHooksMockRecorder is the mock recorder for MockPredictionHooks.
+type MockPredictionHooksMockRecorder struct {
+	mock *MockPredictionHooks
+}
+
+// NewMockPredictionHooks creates a new mock instance.
+func NewMockPredictionHooks(ctrl *gomock.Controller) *MockPredictionHooks {
+	mock := &MockPredictionHooks{ctrl: ctrl}
+	mock.recorder = &MockPredictionHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPredictionHooks) EXPECT() *MockPredictionHooksMockRecorder {
+	return m.recorder
+}
+
+// AfterMarketClosed mocks base method.
+func (m *MockPredictionHooks) AfterMarketClosed(ctx context.Context, marketId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterMarketClosed", ctx, marketId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterMarketClosed indicates an expected call of AfterMarketClosed.
+func (mr *MockPredictionHooksMockRecorder) AfterMarketClosed(ctx, marketId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterMarketClosed", reflect.TypeOf((*MockPredictionHooks)(nil).AfterMarketClosed), ctx, marketId)
+}
+
+// AfterMarketCreated mocks base method.
+func (m *MockPredictionHooks) AfterMarketCreated(ctx context.Context, market types1.PredictionMarket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterMarketCreated", ctx, market)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterMarketCreated indicates an expected call of AfterMarketCreated.
+func (mr *MockPredictionHooksMockRecorder) AfterMarketCreated(ctx, market any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterMarketCreated", reflect.TypeOf((*MockPredictionHooks)(nil).AfterMarketCreated), ctx, market)
+}
+
+// AfterMarketResolved mocks base method.
+func (m *MockPredictionHooks) AfterMarketResolved(ctx context.Context, marketId uint64, outcome string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterMarketResolved", ctx, marketId, outcome)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterMarketResolved indicates an expected call of AfterMarketResolved.
+func (mr *MockPredictionHooksMockRecorder) AfterMarketResolved(ctx, marketId, outcome any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterMarketResolved", reflect.TypeOf((*MockPredictionHooks)(nil).AfterMarketResolved), ctx, marketId, outcome)
+}
+
+// AfterOrderPosted mocks base method.
+func (m *MockPredictionHooks) AfterOrderPosted(ctx context.Context, order types1.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterOrderPosted", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterOrderPosted indicates an expected call of AfterOrderPosted.
+func (mr *MockPredictionHooksMockRecorder) AfterOrderPosted(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterOrderPosted", reflect.TypeOf((*MockPredictionHooks)(nil).AfterOrderPosted), ctx, order)
+}
+
+// AfterTrade mocks base method.
+func (m *MockPredictionHooks) AfterTrade(ctx context.Context, trade types1.Trade) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterTrade", ctx, trade)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterTrade indicates an expected call of AfterTrade.
+func (mr *MockPredictionHooksMockRecorder) AfterTrade(ctx, trade any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterTrade", reflect.TypeOf((*MockPredictionHooks)(nil).AfterTrade), ctx, trade)
+}
+
+// MockParamSubspace is a mock of ParamSubspace interface.
+type MockParamSubspace struct {
+	ctrl     *gomock.Controller
+	recorder *MockParamSubspaceMockRecorder
+	isgomock struct{}
+}
+
+// MockParamSubspaceMockRecorder is the mock recorder for MockParamSubspace.
+type MockParamSubspaceMockRecorder struct {
+	mock *MockParamSubspace
+}
+
+// NewMockParamSubspace creates a new mock instance.
+func NewMockParamSubspace(ctrl *gomock.Controller) *MockParamSubspace {
+	mock := &MockParamSubspace{ctrl: ctrl}
+	mock.recorder = &MockParamSubspaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParamSubspace) EXPECT() *MockParamSubspaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockParamSubspace) Get(arg0 context.Context, arg1 []byte, arg2 any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockParamSubspaceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockParamSubspace)(nil).Get), arg0, arg1, arg2)
+}
+
+// Set mocks base method.
+func (m *MockParamSubspace) Set(arg0 context.Context, arg1 []byte, arg2 any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", arg0, arg1, arg2)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockParamSubspaceMockRecorder) Set(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockParamSubspace)(nil).Set), arg0, arg1, arg2)
+}
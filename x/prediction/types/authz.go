@@ -0,0 +1,152 @@
+package types
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+var _ authz.Authorization = &TradingAuthorization{}
+
+// NewTradingAuthorization creates a TradingAuthorization for msgTypeURL with
+// nothing spent yet.
+func NewTradingAuthorization(msgTypeURL string, marketIds []uint64, groupIds, allowedSides []string, maxDailyNotional math.LegacyDec) *TradingAuthorization {
+	return &TradingAuthorization{
+		MsgTypeUrl:       msgTypeURL,
+		MarketIds:        marketIds,
+		GroupIds:         groupIds,
+		AllowedSides:     allowedSides,
+		MaxDailyNotional: maxDailyNotional,
+		Spent:            math.LegacyZeroDec(),
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TradingAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept. It checks the market and side of
+// the order and adds its notional to the amount spent today. A BUY is charged
+// at its limit price, the most it pays per share. A SELL may be a short sale
+// and a fill is only checked against the resting order by the msg server, so
+// both are charged at a price of 1, the most a share can be worth. The authz
+// store gives Accept no access to the chain state, so it relies on the group
+// and the expected order terms carried by the message, which the msg server
+// enforces.
+func (a TradingAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, errorsmod.Wrap(ErrInvalidRequest, "type mismatch")
+	}
+
+	var (
+		marketId uint64
+		groupId  string
+		side     string
+		notional math.LegacyDec
+	)
+	switch msg := msg.(type) {
+	case *MsgPostOrder:
+		if msg.Amount == nil || !msg.Amount.Amount.IsPositive() {
+			return authz.AcceptResponse{}, errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
+		}
+		price, err := ParsePrice(msg.Price)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		marketId, groupId, side = msg.MarketId, msg.GroupId, msg.Side
+		notional = math.LegacyNewDecFromInt(msg.Amount.Amount)
+		if side == "BUY" {
+			notional = price.MulInt(msg.Amount.Amount)
+		}
+	case *MsgFillOrder:
+		if msg.Amount == nil || !msg.Amount.Amount.IsPositive() {
+			return authz.AcceptResponse{}, errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
+		}
+		if msg.Expected == nil {
+			return authz.AcceptResponse{}, errorsmod.Wrap(ErrUnauthorized, "fills under a trading authorization must set the expected order terms")
+		}
+		if _, err := ParsePrice(msg.Expected.Price); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		// The filler takes the other side of the resting order
+		marketId, groupId, side = msg.Expected.MarketId, msg.Expected.GroupId, "BUY"
+		if msg.Expected.Side == "BUY" {
+			side = "SELL"
+		}
+		notional = math.LegacyNewDecFromInt(msg.Amount.Amount)
+	default:
+		return authz.AcceptResponse{}, errorsmod.Wrap(ErrInvalidRequest, "type mismatch")
+	}
+
+	if err := a.checkMarket(marketId, groupId); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if len(a.AllowedSides) > 0 && !slices.Contains(a.AllowedSides, side) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(ErrUnauthorized, "side %s is not allowed", side)
+	}
+
+	day := sdkCtx.BlockTime().Unix() / secondsPerDay
+	spent := math.LegacyZeroDec()
+	if a.Day == day && !a.Spent.IsNil() {
+		spent = a.Spent
+	}
+	spent = spent.Add(notional)
+	if spent.GT(a.MaxDailyNotional) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(ErrLimitExceeded, "daily notional of %s exceeded", a.MaxDailyNotional)
+	}
+
+	updated := a
+	updated.Spent = spent
+	updated.Day = day
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// checkMarket ensures a market is one of the allowed markets or belongs to
+// one of the allowed groups
+func (a TradingAuthorization) checkMarket(marketId uint64, groupId string) error {
+	if len(a.MarketIds) == 0 && len(a.GroupIds) == 0 {
+		return nil
+	}
+	if slices.Contains(a.MarketIds, marketId) {
+		return nil
+	}
+	if groupId != "" && slices.Contains(a.GroupIds, groupId) {
+		return nil
+	}
+	return errorsmod.Wrapf(ErrUnauthorized, "market %d is not allowed", marketId)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TradingAuthorization) ValidateBasic() error {
+	if a.MsgTypeUrl != sdk.MsgTypeURL(&MsgPostOrder{}) && a.MsgTypeUrl != sdk.MsgTypeURL(&MsgFillOrder{}) {
+		return errorsmod.Wrapf(ErrInvalidRequest, "trading authorization cannot be granted for %s", a.MsgTypeUrl)
+	}
+	if a.MaxDailyNotional.IsNil() || !a.MaxDailyNotional.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidRequest, "max daily notional must be positive")
+	}
+	if !a.Spent.IsNil() && a.Spent.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidRequest, "spent cannot be negative")
+	}
+	for i, side := range a.AllowedSides {
+		if side != "BUY" && side != "SELL" {
+			return errorsmod.Wrapf(ErrInvalidRequest, "invalid side %s", side)
+		}
+		if slices.Contains(a.AllowedSides[:i], side) {
+			return errorsmod.Wrapf(ErrInvalidRequest, "duplicate side %s", side)
+		}
+	}
+	for _, groupId := range a.GroupIds {
+		if groupId == "" {
+			return errorsmod.Wrap(ErrInvalidRequest, "group id cannot be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: speculod/prediction/v1/authz.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TradingAuthorization lets a grantee, typically a trading bot, post or fill
+// orders on behalf of the granter. A grant covers a single message type, so a
+// bot that both posts and fills orders needs one grant for each. Each grant
+// keeps its own daily budget: a bot holding both may trade up to the sum of
+// their max_daily_notional in a day. It never authorizes anything that moves
+// funds out of the granter's account.
+type TradingAuthorization struct {
+	// msg_type_url is the type URL of MsgPostOrder or MsgFillOrder.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// market_ids and group_ids restrict trading to the listed markets and to the
+	// markets of the listed groups. Both empty allows every market. A market is
+	// matched to a group by the group named in the message, and fills must name
+	// the terms of the order they expect.
+	MarketIds []uint64 `protobuf:"varint,2,rep,packed,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	GroupIds  []string `protobuf:"bytes,3,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	// allowed_sides restricts the side the grantee trades, "BUY" or "SELL".
+	// Empty allows both.
+	AllowedSides []string `protobuf:"bytes,4,rep,name=allowed_sides,json=allowedSides,proto3" json:"allowed_sides,omitempty"`
+	// max_daily_notional caps the price-weighted amount traded per UTC day. A
+	// BUY order counts at its limit price, a SELL order or a fill at a price
+	// of 1.
+	MaxDailyNotional cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_daily_notional,json=maxDailyNotional,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_daily_notional"`
+	// spent is the notional already traded on day.
+	Spent cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=spent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spent"`
+	// day is the UTC day, in days since the unix epoch, spent applies to.
+	Day int64 `protobuf:"varint,7,opt,name=day,proto3" json:"day,omitempty"`
+}
+
+func (m *TradingAuthorization) Reset()         { *m = TradingAuthorization{} }
+func (m *TradingAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TradingAuthorization) ProtoMessage()    {}
+func (*TradingAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad147e19234a6706, []int{0}
+}
+func (m *TradingAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingAuthorization.Merge(m, src)
+}
+func (m *TradingAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingAuthorization proto.InternalMessageInfo
+
+func (m *TradingAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TradingAuthorization) GetMarketIds() []uint64 {
+	if m != nil {
+		return m.MarketIds
+	}
+	return nil
+}
+
+func (m *TradingAuthorization) GetGroupIds() []string {
+	if m != nil {
+		return m.GroupIds
+	}
+	return nil
+}
+
+func (m *TradingAuthorization) GetAllowedSides() []string {
+	if m != nil {
+		return m.AllowedSides
+	}
+	return nil
+}
+
+func (m *TradingAuthorization) GetDay() int64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TradingAuthorization)(nil), "speculod.prediction.v1.TradingAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("speculod/prediction/v1/authz.proto", fileDescriptor_ad147e19234a6706)
+}
+
+var fileDescriptor_ad147e19234a6706 = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbf, 0x6b, 0xdc, 0x30,
+	0x14, 0xb6, 0xeb, 0x24, 0xad, 0x45, 0x0a, 0xa9, 0x08, 0xc5, 0xcd, 0x51, 0xc7, 0x5c, 0x17, 0x73,
+	0x10, 0x1b, 0x53, 0xda, 0xa1, 0x5b, 0xc3, 0x2d, 0x85, 0xd0, 0xc1, 0x4d, 0x96, 0x2e, 0x46, 0xb1,
+	0x84, 0x4f, 0xc4, 0xb2, 0x8c, 0x24, 0x5f, 0xcf, 0xf9, 0x13, 0x3a, 0xf5, 0xcf, 0xe8, 0x98, 0x21,
+	0xf4, 0x6f, 0x38, 0x3a, 0x1d, 0x9d, 0x4a, 0x87, 0xa3, 0xdc, 0x0d, 0xf7, 0x6f, 0x14, 0x59, 0xee,
+	0x2f, 0xb8, 0x2d, 0x8b, 0x78, 0xef, 0xfb, 0x3e, 0x3e, 0x9e, 0xde, 0xf7, 0xc0, 0x50, 0xd6, 0x24,
+	0x6f, 0x4a, 0x8e, 0xe3, 0x5a, 0x10, 0x4c, 0x73, 0x45, 0x79, 0x15, 0x4f, 0x93, 0x18, 0x35, 0x6a,
+	0x72, 0x1d, 0xd5, 0x82, 0x2b, 0x0e, 0x1f, 0xff, 0xd6, 0x44, 0x7f, 0x35, 0xd1, 0x34, 0x39, 0x7a,
+	0x84, 0x18, 0xad, 0x78, 0xdc, 0xbd, 0x46, 0x7a, 0xf4, 0x24, 0xe7, 0x92, 0x71, 0x99, 0x75, 0x5d,
+	0x6c, 0x9a, 0x9e, 0x3a, 0x2c, 0x78, 0xc1, 0x0d, 0xae, 0x2b, 0x83, 0x0e, 0xbf, 0x38, 0xe0, 0xf0,
+	0x5c, 0x20, 0x4c, 0xab, 0xe2, 0x75, 0xa3, 0x26, 0x5c, 0xd0, 0x6b, 0xa4, 0xfd, 0x61, 0x00, 0xf6,
+	0x99, 0x2c, 0x32, 0xd5, 0xd6, 0x24, 0x6b, 0x44, 0xe9, 0xd9, 0x81, 0x1d, 0xba, 0x29, 0x60, 0xb2,
+	0x38, 0x6f, 0x6b, 0x72, 0x21, 0x4a, 0xf8, 0x14, 0x00, 0x86, 0xc4, 0x15, 0x51, 0x19, 0xc5, 0xd2,
+	0xbb, 0x17, 0x38, 0xe1, 0x4e, 0xea, 0x1a, 0xe4, 0x0d, 0x96, 0x70, 0x00, 0xdc, 0x42, 0xf0, 0xa6,
+	0xee, 0x58, 0x27, 0x70, 0x42, 0x37, 0x7d, 0xd0, 0x01, 0x9a, 0x7c, 0x06, 0x1e, 0xa2, 0xb2, 0xe4,
+	0x1f, 0x08, 0xce, 0x24, 0xc5, 0x44, 0x7a, 0x3b, 0x9d, 0x60, 0xbf, 0x07, 0xdf, 0x69, 0x0c, 0x62,
+	0x00, 0x19, 0x9a, 0x65, 0x18, 0xd1, 0xb2, 0xcd, 0x2a, 0xae, 0xc7, 0x42, 0xa5, 0xb7, 0xab, 0x07,
+	0x39, 0x7d, 0x39, 0x5f, 0x1e, 0x5b, 0x3f, 0x96, 0xc7, 0x03, 0xf3, 0x47, 0x89, 0xaf, 0x22, 0xca,
+	0x63, 0x86, 0xd4, 0x24, 0x3a, 0x23, 0x05, 0xca, 0xdb, 0x31, 0xc9, 0xbf, 0xdd, 0x9e, 0x80, 0x7e,
+	0x05, 0x63, 0x92, 0x7f, 0xde, 0xdc, 0x8c, 0xec, 0xf4, 0x80, 0xa1, 0xd9, 0x58, 0x1b, 0xbe, 0xed,
+	0xfd, 0xe0, 0x19, 0xd8, 0x95, 0x35, 0xa9, 0x94, 0xb7, 0x77, 0x27, 0x63, 0x63, 0x02, 0x0f, 0x80,
+	0x83, 0x51, 0xeb, 0xdd, 0x0f, 0xec, 0xd0, 0x49, 0x75, 0xf9, 0xea, 0xe2, 0xeb, 0xed, 0xc9, 0xb0,
+	0x57, 0x9b, 0x54, 0xa7, 0xc9, 0x25, 0x51, 0x28, 0x89, 0xfe, 0x5b, 0xf8, 0xc7, 0xcd, 0xcd, 0x68,
+	0xf4, 0xe7, 0x18, 0x66, 0xff, 0x9e, 0xc3, 0xb6, 0x7c, 0x4e, 0x5f, 0xcc, 0x57, 0xbe, 0xbd, 0x58,
+	0xf9, 0xf6, 0xcf, 0x95, 0x6f, 0x7f, 0x5a, 0xfb, 0xd6, 0x62, 0xed, 0x5b, 0xdf, 0xd7, 0xbe, 0xf5,
+	0x7e, 0xb0, 0xdd, 0x45, 0x27, 0x29, 0x2f, 0xf7, 0xba, 0xd8, 0x9f, 0xff, 0x0a, 0x00, 0x00, 0xff,
+	0xff, 0xc3, 0xb4, 0xe6, 0xab, 0x78, 0x02, 0x00, 0x00,
+}
+
+func (m *TradingAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Day != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxDailyNotional.Size()
+		i -= size
+		if _, err := m.MaxDailyNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AllowedSides) > 0 {
+		for iNdEx := len(m.AllowedSides) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSides[iNdEx])
+			copy(dAtA[i:], m.AllowedSides[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedSides[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GroupIds) > 0 {
+		for iNdEx := len(m.GroupIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupIds[iNdEx])
+			copy(dAtA[i:], m.GroupIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.GroupIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MarketIds) > 0 {
+		dAtA2 := make([]byte, len(m.MarketIds)*10)
+		var j1 int
+		for _, num := range m.MarketIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TradingAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.MarketIds) > 0 {
+		l = 0
+		for _, e := range m.MarketIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.GroupIds) > 0 {
+		for _, s := range m.GroupIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedSides) > 0 {
+		for _, s := range m.AllowedSides {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.MaxDailyNotional.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.Day != 0 {
+		n += 1 + sovAuthz(uint64(m.Day))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TradingAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MarketIds = append(m.MarketIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MarketIds) == 0 {
+					m.MarketIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MarketIds = append(m.MarketIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupIds = append(m.GroupIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSides", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSides = append(m.AllowedSides, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDailyNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDailyNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
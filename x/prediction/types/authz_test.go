@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/prediction/types"
+)
+
+func TestTradingAuthorization_ValidateBasic(t *testing.T) {
+	postOrder := sdk.MsgTypeURL(&types.MsgPostOrder{})
+	tests := []struct {
+		desc  string
+		auth  *types.TradingAuthorization
+		valid bool
+	}{
+		{
+			desc:  "post order",
+			auth:  types.NewTradingAuthorization(postOrder, []uint64{1}, nil, []string{"BUY"}, math.LegacyNewDec(100)),
+			valid: true,
+		},
+		{
+			desc:  "fill order",
+			auth:  types.NewTradingAuthorization(sdk.MsgTypeURL(&types.MsgFillOrder{}), nil, []string{"weather"}, nil, math.LegacyNewDec(100)),
+			valid: true,
+		},
+		{
+			desc: "other message",
+			auth: types.NewTradingAuthorization(sdk.MsgTypeURL(&types.MsgCreateMarket{}), nil, nil, nil, math.LegacyNewDec(100)),
+		},
+		{
+			desc: "no notional",
+			auth: types.NewTradingAuthorization(postOrder, nil, nil, nil, math.LegacyZeroDec()),
+		},
+		{
+			desc: "invalid side",
+			auth: types.NewTradingAuthorization(postOrder, nil, nil, []string{"HOLD"}, math.LegacyNewDec(100)),
+		},
+		{
+			desc: "duplicate side",
+			auth: types.NewTradingAuthorization(postOrder, nil, nil, []string{"SELL", "SELL"}, math.LegacyNewDec(100)),
+		},
+		{
+			desc: "empty group",
+			auth: types.NewTradingAuthorization(postOrder, nil, []string{""}, nil, math.LegacyNewDec(100)),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
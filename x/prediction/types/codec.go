@@ -4,9 +4,11 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil))
+	registrar.RegisterImplementations((*authz.Authorization)(nil), &TradingAuthorization{})
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
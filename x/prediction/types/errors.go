@@ -16,4 +16,13 @@ var (
 	ErrInsufficientPosition = errors.Register(ModuleName, 1105, "insufficient position to sell")
 	ErrTransferFailed       = errors.Register(ModuleName, 1106, "transfer failed")
 	ErrPositionUpdateFailed = errors.Register(ModuleName, 1107, "position update failed")
+	ErrTwapUnavailable      = errors.Register(ModuleName, 1108, "twap unavailable")
+	ErrMarketClosed         = errors.Register(ModuleName, 1109, "market closed")
+	ErrGroupNotFound        = errors.Register(ModuleName, 1110, "group not found")
+	ErrGroupExists          = errors.Register(ModuleName, 1111, "group already exists")
+	ErrUnauthorized         = errors.Register(ModuleName, 1112, "unauthorized")
+	ErrLimitExceeded        = errors.Register(ModuleName, 1113, "position limit exceeded")
+	ErrTemplateNotFound     = errors.Register(ModuleName, 1114, "market template not found")
+	ErrInvariantBroken      = errors.Register(ModuleName, 1115, "invariant broken")
+	ErrInvalidPrice         = errors.Register(ModuleName, 1116, "invalid price")
 )
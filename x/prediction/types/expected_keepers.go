@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -22,6 +24,35 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// GroupKeeper defines the expected interface for the Group module.
+type GroupKeeper interface {
+	GroupInfo(context.Context, *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error)
+	GroupMembers(context.Context, *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error)
+	GroupPolicyInfo(context.Context, *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error)
+}
+
+// EpochsKeeper defines the expected interface for the Epochs module.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, error)
+	AllEpochInfos(ctx sdk.Context) ([]epochstypes.EpochInfo, error)
+}
+
+// PredictionHooks is the event hooks interface for the prediction module.
+// Hooks run after the corresponding state change has been written.
+type PredictionHooks interface {
+	AfterMarketCreated(ctx context.Context, market PredictionMarket) error
+	AfterOrderPosted(ctx context.Context, order Order) error
+	AfterTrade(ctx context.Context, trade Trade) error
+	AfterMarketClosed(ctx context.Context, marketId uint64) error
+	AfterMarketResolved(ctx context.Context, marketId uint64, outcome string) error
+}
+
+// PredictionHooksWrapper is a wrapper for modules to inject PredictionHooks using depinject.
+type PredictionHooksWrapper struct{ PredictionHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (PredictionHooksWrapper) IsOnePerModuleType() {}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
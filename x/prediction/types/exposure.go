@@ -0,0 +1,26 @@
+package types
+
+import "cosmossdk.io/math"
+
+// NewExposure returns an exposure with nothing at stake.
+func NewExposure() Exposure {
+	return Exposure{
+		Position:     math.ZeroInt(),
+		Cost:         math.LegacyZeroDec(),
+		OpenBuy:      math.ZeroInt(),
+		OpenSell:     math.ZeroInt(),
+		OpenNotional: math.LegacyZeroDec(),
+	}
+}
+
+// IsZero reports whether nothing is at stake.
+func (e Exposure) IsZero() bool {
+	return e.Position.IsZero() && e.Cost.IsZero() && e.OpenBuy.IsZero() &&
+		e.OpenSell.IsZero() && e.OpenNotional.IsZero() && e.OpenOrders == 0
+}
+
+// Notional is the amount at stake counted against the notional limit: the
+// net cost of the position plus the price-weighted resting orders.
+func (e Exposure) Notional() math.LegacyDec {
+	return e.Cost.Abs().Add(e.OpenNotional)
+}
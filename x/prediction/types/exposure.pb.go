@@ -0,0 +1,560 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: speculod/prediction/v1/exposure.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Exposure is what an account has at stake on one outcome of a market. It is
+// updated as the account's orders rest on the book, fill and leave it, so
+// position limits are checked without walking the orders or trades.
+type Exposure struct {
+	// position is the net amount bought on the outcome, negative when more has
+	// been sold than bought. Stored positions count as bought at a price of 1.
+	Position cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=position,proto3,customtype=cosmossdk.io/math.Int" json:"position"`
+	// cost is the net amount paid for the position at trade prices.
+	Cost cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=cost,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cost"`
+	// open_buy is the unfilled amount of the resting buy orders.
+	OpenBuy cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=open_buy,json=openBuy,proto3,customtype=cosmossdk.io/math.Int" json:"open_buy"`
+	// open_sell is the unfilled amount of the resting sell orders.
+	OpenSell cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=open_sell,json=openSell,proto3,customtype=cosmossdk.io/math.Int" json:"open_sell"`
+	// open_notional is the price-weighted unfilled amount of the resting orders.
+	OpenNotional cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=open_notional,json=openNotional,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"open_notional"`
+	// open_orders is the number of resting orders.
+	OpenOrders uint32 `protobuf:"varint,6,opt,name=open_orders,json=openOrders,proto3" json:"open_orders,omitempty"`
+}
+
+func (m *Exposure) Reset()         { *m = Exposure{} }
+func (m *Exposure) String() string { return proto.CompactTextString(m) }
+func (*Exposure) ProtoMessage()    {}
+func (*Exposure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c81ba31c5757b317, []int{0}
+}
+func (m *Exposure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Exposure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Exposure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Exposure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Exposure.Merge(m, src)
+}
+func (m *Exposure) XXX_Size() int {
+	return m.Size()
+}
+func (m *Exposure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Exposure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Exposure proto.InternalMessageInfo
+
+func (m *Exposure) GetOpenOrders() uint32 {
+	if m != nil {
+		return m.OpenOrders
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Exposure)(nil), "speculod.prediction.v1.Exposure")
+}
+
+func init() {
+	proto.RegisterFile("speculod/prediction/v1/exposure.proto", fileDescriptor_c81ba31c5757b317)
+}
+
+var fileDescriptor_c81ba31c5757b317 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x2e, 0x48, 0x4d,
+	0x2e, 0xcd, 0xc9, 0x4f, 0xd1, 0x2f, 0x28, 0x4a, 0x4d, 0xc9, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3,
+	0x2f, 0x33, 0xd4, 0x4f, 0xad, 0x28, 0xc8, 0x2f, 0x2e, 0x2d, 0x4a, 0xd5, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x12, 0x83, 0x29, 0xd3, 0x43, 0x28, 0xd3, 0x2b, 0x33, 0x94, 0x92, 0x4c, 0xce, 0x2f,
+	0xce, 0xcd, 0x2f, 0x8e, 0x07, 0xab, 0xd2, 0x87, 0x70, 0x20, 0x5a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3,
+	0xf3, 0x21, 0xe2, 0x20, 0x16, 0x44, 0x54, 0x69, 0x29, 0x33, 0x17, 0x87, 0x2b, 0xd4, 0x6c, 0x21,
+	0x77, 0x2e, 0x8e, 0x82, 0xfc, 0xe2, 0x4c, 0x90, 0x61, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e,
+	0xda, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0x2f, 0x0a, 0x31, 0xaa, 0x38, 0x25, 0x5b, 0x2f,
+	0x33, 0x5f, 0x3f, 0x37, 0xb1, 0x24, 0x43, 0xcf, 0x33, 0xaf, 0xe4, 0xd2, 0x16, 0x5d, 0x2e, 0xa8,
+	0x1d, 0x9e, 0x79, 0x25, 0x41, 0x70, 0xcd, 0x42, 0xae, 0x5c, 0x2c, 0xc9, 0xf9, 0xc5, 0x25, 0x12,
+	0x4c, 0x60, 0x43, 0x0c, 0xa1, 0x86, 0x48, 0x63, 0x1a, 0xe2, 0x93, 0x9a, 0x9e, 0x98, 0x5c, 0xe9,
+	0x92, 0x9a, 0x8c, 0x64, 0x94, 0x4b, 0x6a, 0x72, 0x10, 0x58, 0xbb, 0x90, 0x1b, 0x17, 0x47, 0x7e,
+	0x41, 0x6a, 0x5e, 0x7c, 0x52, 0x69, 0xa5, 0x04, 0x33, 0xe9, 0xee, 0x61, 0x07, 0x69, 0x76, 0x2a,
+	0xad, 0x14, 0xf2, 0xe0, 0xe2, 0x04, 0x9b, 0x53, 0x9c, 0x9a, 0x93, 0x23, 0xc1, 0x42, 0x86, 0xc7,
+	0x40, 0xba, 0x83, 0x53, 0x73, 0x72, 0x84, 0xc2, 0xb8, 0x78, 0xc1, 0x26, 0xe5, 0xe5, 0x83, 0xfc,
+	0x99, 0x98, 0x23, 0xc1, 0x4a, 0xae, 0x0f, 0x79, 0x40, 0xe6, 0xf8, 0x41, 0x8d, 0x11, 0x92, 0xe7,
+	0xe2, 0x06, 0x9b, 0x9b, 0x5f, 0x94, 0x92, 0x5a, 0x54, 0x2c, 0xc1, 0xa6, 0xc0, 0xa8, 0xc1, 0x1b,
+	0xc4, 0x05, 0x12, 0xf2, 0x07, 0x8b, 0x38, 0x99, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
+	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
+	0x1c, 0x43, 0x94, 0x34, 0x3c, 0xc5, 0x54, 0x20, 0xa7, 0x99, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
+	0x36, 0x70, 0x2c, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x05, 0x50, 0x79, 0x57, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Exposure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Exposure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Exposure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenOrders != 0 {
+		i = encodeVarintExposure(dAtA, i, uint64(m.OpenOrders))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.OpenNotional.Size()
+		i -= size
+		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OpenSell.Size()
+		i -= size
+		if _, err := m.OpenSell.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OpenBuy.Size()
+		i -= size
+		if _, err := m.OpenBuy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Position.Size()
+		i -= size
+		if _, err := m.Position.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExposure(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExposure(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Exposure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.OpenBuy.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.OpenSell.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.OpenNotional.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	if m.OpenOrders != 0 {
+		n += 1 + sovExposure(uint64(m.OpenOrders))
+	}
+	return n
+}
+
+func sovExposure(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExposure(x uint64) (n int) {
+	return sovExposure(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Exposure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExposure
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Exposure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Exposure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenBuy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenBuy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenSell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenSell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenOrders", wireType)
+			}
+			m.OpenOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExposure(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExposure(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExposure
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExposure
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExposure
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExposure
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExposure        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExposure          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExposure = fmt.Errorf("proto: unexpected end of group")
+)
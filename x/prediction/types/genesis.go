@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +12,132 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	groups := make(map[string]struct{}, len(gs.Groups))
+	for _, g := range gs.Groups {
+		if g.Id == "" {
+			return fmt.Errorf("group id cannot be empty")
+		}
+		if _, dup := groups[g.Id]; dup {
+			return fmt.Errorf("duplicate group id %s", g.Id)
+		}
+		groups[g.Id] = struct{}{}
+	}
+
+	templateIds := make(map[uint64]struct{}, len(gs.Templates))
+	for _, tmpl := range gs.Templates {
+		if _, dup := templateIds[tmpl.Id]; dup {
+			return fmt.Errorf("duplicate template id %d", tmpl.Id)
+		}
+		if tmpl.Id >= gs.TemplateIdSeq {
+			return fmt.Errorf("template id %d is not below the template sequence %d", tmpl.Id, gs.TemplateIdSeq)
+		}
+		if _, found := groups[tmpl.GroupId]; tmpl.GroupId != "" && !found {
+			return fmt.Errorf("template %d references unknown group %s", tmpl.Id, tmpl.GroupId)
+		}
+		if tmpl.BondEscrowed && (tmpl.Bond == nil || !tmpl.Bond.IsPositive()) {
+			return fmt.Errorf("template %d has an escrowed bond but no bond", tmpl.Id)
+		}
+		templateIds[tmpl.Id] = struct{}{}
+	}
+
+	markets := make(map[uint64]PredictionMarket, len(gs.Markets))
+	for _, market := range gs.Markets {
+		if _, dup := markets[market.Id]; dup {
+			return fmt.Errorf("duplicate market id %d", market.Id)
+		}
+		if market.Id >= gs.MarketIdSeq {
+			return fmt.Errorf("market id %d is not below the market sequence %d", market.Id, gs.MarketIdSeq)
+		}
+		if _, found := groups[market.GroupId]; market.GroupId != "" && !found {
+			return fmt.Errorf("market %d references unknown group %s", market.Id, market.GroupId)
+		}
+		if _, found := templateIds[market.TemplateId]; market.FromTemplate && !found {
+			return fmt.Errorf("market %d references unknown template %d", market.Id, market.TemplateId)
+		}
+		markets[market.Id] = market
+	}
+	// checkOutcome ensures an entry references an existing market outcome
+	checkOutcome := func(entry string, marketId uint64, outcomeIndex uint32) error {
+		market, found := markets[marketId]
+		if !found {
+			return fmt.Errorf("%s references unknown market %d", entry, marketId)
+		}
+		if outcomeIndex >= uint32(len(market.Outcomes)) {
+			return fmt.Errorf("%s outcome index %d out of range for market %d", entry, outcomeIndex, marketId)
+		}
+		return nil
+	}
+
+	orderIds := make(map[uint64]struct{}, len(gs.Orders))
+	for _, order := range gs.Orders {
+		if _, dup := orderIds[order.Id]; dup {
+			return fmt.Errorf("duplicate order id %d", order.Id)
+		}
+		if order.Id >= gs.OrderIdSeq {
+			return fmt.Errorf("order id %d is not below the order sequence %d", order.Id, gs.OrderIdSeq)
+		}
+		if err := checkOutcome(fmt.Sprintf("order %d", order.Id), order.MarketId, order.OutcomeIndex); err != nil {
+			return err
+		}
+		if _, err := ParsePrice(order.Price); err != nil {
+			return fmt.Errorf("order %d: %w", order.Id, err)
+		}
+		orderIds[order.Id] = struct{}{}
+	}
+
+	positions := make(map[string]struct{}, len(gs.Positions))
+	for _, pos := range gs.Positions {
+		key := fmt.Sprintf("%d/%s/%d", pos.Position.MarketId, pos.Position.Owner, pos.OutcomeIndex)
+		if _, dup := positions[key]; dup {
+			return fmt.Errorf("duplicate position %s", key)
+		}
+		if err := checkOutcome("position "+key, pos.Position.MarketId, pos.OutcomeIndex); err != nil {
+			return err
+		}
+		positions[key] = struct{}{}
+	}
+
+	tradeIds := make(map[uint64]struct{}, len(gs.Trades))
+	for _, trade := range gs.Trades {
+		if _, dup := tradeIds[trade.TradeId]; dup {
+			return fmt.Errorf("duplicate trade id %d", trade.TradeId)
+		}
+		if trade.TradeId >= gs.TradeIdSeq {
+			return fmt.Errorf("trade id %d is not below the trade sequence %d", trade.TradeId, gs.TradeIdSeq)
+		}
+		if err := checkOutcome(fmt.Sprintf("trade %d", trade.TradeId), trade.MarketId, trade.OutcomeIndex); err != nil {
+			return err
+		}
+		tradeIds[trade.TradeId] = struct{}{}
+	}
+
+	lastPrices := make(map[[2]uint64]struct{}, len(gs.LastTradePrices))
+	for _, price := range gs.LastTradePrices {
+		key := [2]uint64{price.MarketId, uint64(price.OutcomeIndex)}
+		if _, dup := lastPrices[key]; dup {
+			return fmt.Errorf("duplicate last trade price for market %d outcome %d", price.MarketId, price.OutcomeIndex)
+		}
+		if err := checkOutcome("last trade price", price.MarketId, price.OutcomeIndex); err != nil {
+			return err
+		}
+		lastPrices[key] = struct{}{}
+	}
+
+	twapRecords := make(map[[3]uint64]struct{}, len(gs.TwapRecords))
+	for _, record := range gs.TwapRecords {
+		key := [3]uint64{record.MarketId, uint64(record.OutcomeIndex), uint64(record.Timestamp)}
+		if _, dup := twapRecords[key]; dup {
+			return fmt.Errorf("duplicate twap record for market %d outcome %d at %d", record.MarketId, record.OutcomeIndex, record.Timestamp)
+		}
+		if err := checkOutcome("twap record", record.MarketId, record.OutcomeIndex); err != nil {
+			return err
+		}
+		twapRecords[key] = struct{}{}
+	}
+
+	return nil
 }
@@ -27,7 +27,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the prediction module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params          Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Markets         []PredictionMarket      `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets"`
+	Orders          []Order                 `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders"`
+	Positions       []GenesisPosition       `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions"`
+	Trades          []Trade                 `protobuf:"bytes,5,rep,name=trades,proto3" json:"trades"`
+	LastTradePrices []GenesisLastTradePrice `protobuf:"bytes,6,rep,name=last_trade_prices,json=lastTradePrices,proto3" json:"last_trade_prices"`
+	TwapRecords     []TwapRecord            `protobuf:"bytes,7,rep,name=twap_records,json=twapRecords,proto3" json:"twap_records"`
+	Groups          []MarketGroup           `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups"`
+	Templates       []MarketTemplate        `protobuf:"bytes,9,rep,name=templates,proto3" json:"templates"`
+	// The *_seq fields are the next ID of each sequence.
+	MarketIdSeq   uint64 `protobuf:"varint,10,opt,name=market_id_seq,json=marketIdSeq,proto3" json:"market_id_seq,omitempty"`
+	OrderIdSeq    uint64 `protobuf:"varint,11,opt,name=order_id_seq,json=orderIdSeq,proto3" json:"order_id_seq,omitempty"`
+	TradeIdSeq    uint64 `protobuf:"varint,12,opt,name=trade_id_seq,json=tradeIdSeq,proto3" json:"trade_id_seq,omitempty"`
+	TemplateIdSeq uint64 `protobuf:"varint,13,opt,name=template_id_seq,json=templateIdSeq,proto3" json:"template_id_seq,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,8 +83,217 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMarkets() []PredictionMarket {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositions() []GenesisPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTradePrices() []GenesisLastTradePrice {
+	if m != nil {
+		return m.LastTradePrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRecords() []TwapRecord {
+	if m != nil {
+		return m.TwapRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroups() []MarketGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTemplates() []MarketTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketIdSeq() uint64 {
+	if m != nil {
+		return m.MarketIdSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetOrderIdSeq() uint64 {
+	if m != nil {
+		return m.OrderIdSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTradeIdSeq() uint64 {
+	if m != nil {
+		return m.TradeIdSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTemplateIdSeq() uint64 {
+	if m != nil {
+		return m.TemplateIdSeq
+	}
+	return 0
+}
+
+// GenesisPosition is a position together with the outcome it is held on.
+type GenesisPosition struct {
+	OutcomeIndex uint32   `protobuf:"varint,1,opt,name=outcome_index,json=outcomeIndex,proto3" json:"outcome_index,omitempty"`
+	Position     Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position"`
+}
+
+func (m *GenesisPosition) Reset()         { *m = GenesisPosition{} }
+func (m *GenesisPosition) String() string { return proto.CompactTextString(m) }
+func (*GenesisPosition) ProtoMessage()    {}
+func (*GenesisPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e4aba039bf9ce5, []int{1}
+}
+func (m *GenesisPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPosition.Merge(m, src)
+}
+func (m *GenesisPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPosition proto.InternalMessageInfo
+
+func (m *GenesisPosition) GetOutcomeIndex() uint32 {
+	if m != nil {
+		return m.OutcomeIndex
+	}
+	return 0
+}
+
+func (m *GenesisPosition) GetPosition() Position {
+	if m != nil {
+		return m.Position
+	}
+	return Position{}
+}
+
+// GenesisLastTradePrice is the last traded price of a market outcome.
+type GenesisLastTradePrice struct {
+	MarketId     uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OutcomeIndex uint32 `protobuf:"varint,2,opt,name=outcome_index,json=outcomeIndex,proto3" json:"outcome_index,omitempty"`
+	Price        string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// twap_pending is set when the outcome traded since the last TWAP update.
+	TwapPending bool `protobuf:"varint,4,opt,name=twap_pending,json=twapPending,proto3" json:"twap_pending,omitempty"`
+}
+
+func (m *GenesisLastTradePrice) Reset()         { *m = GenesisLastTradePrice{} }
+func (m *GenesisLastTradePrice) String() string { return proto.CompactTextString(m) }
+func (*GenesisLastTradePrice) ProtoMessage()    {}
+func (*GenesisLastTradePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e4aba039bf9ce5, []int{2}
+}
+func (m *GenesisLastTradePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisLastTradePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisLastTradePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisLastTradePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisLastTradePrice.Merge(m, src)
+}
+func (m *GenesisLastTradePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisLastTradePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisLastTradePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisLastTradePrice proto.InternalMessageInfo
+
+func (m *GenesisLastTradePrice) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *GenesisLastTradePrice) GetOutcomeIndex() uint32 {
+	if m != nil {
+		return m.OutcomeIndex
+	}
+	return 0
+}
+
+func (m *GenesisLastTradePrice) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *GenesisLastTradePrice) GetTwapPending() bool {
+	if m != nil {
+		return m.TwapPending
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "speculod.prediction.v1.GenesisState")
+	proto.RegisterType((*GenesisPosition)(nil), "speculod.prediction.v1.GenesisPosition")
+	proto.RegisterType((*GenesisLastTradePrice)(nil), "speculod.prediction.v1.GenesisLastTradePrice")
 }
 
 func init() {
@@ -79,20 +301,47 @@ func init() {
 }
 
 var fileDescriptor_93e4aba039bf9ce5 = []byte{
-	// 200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x2e, 0x48, 0x4d,
-	0x2e, 0xcd, 0xc9, 0x4f, 0xd1, 0x2f, 0x28, 0x4a, 0x4d, 0xc9, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xa9, 0xd2, 0x43, 0xa8, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xa5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x15, 0x55, 0xc6, 0x61, 0x4d, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x16, 0xa5, 0x40, 0x2e,
-	0x1e, 0x77, 0x88, 0xb5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x8e, 0x5c, 0x6c, 0x10, 0x79, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39, 0x3d, 0xec, 0xce, 0xd0, 0x0b, 0x00, 0xab, 0x72, 0xe2,
-	0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x8d, 0x4e, 0xa6, 0x27,
-	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x0d, 0x77, 0x51, 0x05, 0xb2, 0x9b,
-	0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x0e, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xcd, 0x0f, 0xb1, 0x06, 0x1e, 0x01, 0x00, 0x00,
+	// 628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xad, 0xeb, 0x1a, 0xb7, 0xd5, 0x34, 0x6b, 0x20, 0x6b, 0x13, 0x59, 0x96, 0xc1,
+	0x88, 0x90, 0x48, 0xb5, 0x21, 0x4e, 0x9c, 0xe8, 0x65, 0x8c, 0x1f, 0xa2, 0xca, 0x76, 0xe2, 0x12,
+	0x85, 0xc6, 0xaa, 0x22, 0xda, 0x38, 0x8b, 0xbd, 0xad, 0xf0, 0x57, 0x20, 0xfe, 0x0a, 0x6e, 0xf0,
+	0x67, 0xec, 0xb8, 0x23, 0x27, 0x84, 0xda, 0x03, 0xff, 0x06, 0xca, 0xb3, 0x9d, 0x31, 0xa8, 0xd7,
+	0x4b, 0x14, 0x3f, 0x7d, 0xbe, 0x5f, 0x3f, 0xbf, 0xf7, 0x6c, 0x74, 0x9f, 0xe7, 0x74, 0x70, 0x36,
+	0x62, 0x49, 0x37, 0x2f, 0x68, 0x92, 0x0e, 0x44, 0xca, 0xb2, 0xee, 0xf9, 0x7e, 0x77, 0x48, 0x33,
+	0xca, 0x53, 0x1e, 0xe4, 0x05, 0x13, 0x0c, 0xdf, 0xd5, 0x54, 0x70, 0x4d, 0x05, 0xe7, 0xfb, 0x9b,
+	0xeb, 0xf1, 0x38, 0xcd, 0x58, 0x17, 0xbe, 0x12, 0xdd, 0xdc, 0x18, 0xb2, 0x21, 0x83, 0xdf, 0x6e,
+	0xf9, 0xa7, 0xa2, 0x9e, 0x69, 0x9b, 0x82, 0x9d, 0xe5, 0x0b, 0x18, 0x56, 0x24, 0xb4, 0x50, 0xcc,
+	0xae, 0x81, 0xc9, 0xe3, 0x22, 0x1e, 0xab, 0x6c, 0x37, 0x1f, 0x98, 0x20, 0xc6, 0x53, 0xc8, 0x5c,
+	0x62, 0x81, 0x09, 0xab, 0x56, 0xd1, 0x38, 0x2e, 0x3e, 0x50, 0xb1, 0xc0, 0x56, 0xd0, 0x71, 0x3e,
+	0x8a, 0x05, 0x55, 0xd8, 0x8e, 0x09, 0xbb, 0x88, 0xf5, 0x49, 0xb7, 0x4d, 0xc8, 0x44, 0x02, 0xde,
+	0xb7, 0x06, 0x6a, 0x1f, 0xca, 0x0e, 0x1c, 0x8b, 0x58, 0x50, 0xfc, 0x1c, 0x35, 0xe4, 0x11, 0x89,
+	0xe5, 0x5a, 0x7e, 0xeb, 0xc0, 0x09, 0xe6, 0x77, 0x24, 0xe8, 0x03, 0xd5, 0xb3, 0x2f, 0x7f, 0x6e,
+	0xd7, 0xbe, 0xfe, 0xfe, 0xfe, 0xc8, 0x0a, 0x95, 0x10, 0xbf, 0x40, 0xab, 0xf2, 0x38, 0x9c, 0x2c,
+	0xb9, 0xcb, 0x7e, 0xeb, 0xc0, 0x37, 0x7a, 0x54, 0xab, 0x37, 0x20, 0xe8, 0xd5, 0x4b, 0xb7, 0x50,
+	0xcb, 0xf1, 0x33, 0xd4, 0x80, 0x9e, 0x70, 0xb2, 0x0c, 0x46, 0xf7, 0x4c, 0x46, 0x6f, 0x4b, 0x4a,
+	0xa9, 0x95, 0x04, 0xbf, 0x42, 0xb6, 0xee, 0x03, 0x27, 0x75, 0xd0, 0x3f, 0x34, 0xe9, 0x55, 0x09,
+	0xfa, 0x8a, 0x57, 0x4e, 0xd7, 0xfa, 0x32, 0x13, 0x51, 0xc4, 0x09, 0xe5, 0x64, 0xe5, 0xf6, 0x4c,
+	0x4e, 0x4a, 0x4a, 0x67, 0x22, 0x25, 0x38, 0x42, 0xeb, 0xa3, 0x98, 0x8b, 0x08, 0x96, 0x51, 0x5e,
+	0xa4, 0x03, 0xca, 0x49, 0x03, 0x7c, 0x1e, 0x2f, 0xc8, 0xe8, 0x75, 0xcc, 0x05, 0x58, 0xf6, 0x4b,
+	0x95, 0xf2, 0x5d, 0x1b, 0xdd, 0x88, 0x96, 0x47, 0x6d, 0x97, 0x4d, 0x8f, 0x0a, 0x3a, 0x60, 0x45,
+	0xc2, 0xc9, 0x2a, 0x78, 0x7b, 0xc6, 0x1c, 0x2f, 0xe2, 0x3c, 0x04, 0x54, 0x19, 0xb6, 0x44, 0x15,
+	0xe1, 0xe5, 0x04, 0xc0, 0x65, 0xe1, 0xa4, 0x09, 0x36, 0xbb, 0x26, 0x1b, 0xd9, 0xb3, 0xc3, 0x92,
+	0xd5, 0x07, 0x96, 0x42, 0xfc, 0x12, 0xd9, 0x7a, 0x56, 0x39, 0xb1, 0xc1, 0x65, 0xef, 0x76, 0x97,
+	0x13, 0x85, 0xeb, 0xca, 0x57, 0x72, 0xec, 0xa1, 0x8e, 0x1c, 0x87, 0x28, 0x4d, 0x22, 0x4e, 0x4f,
+	0x09, 0x72, 0x2d, 0xbf, 0x1e, 0xb6, 0x64, 0xf0, 0x28, 0x39, 0xa6, 0xa7, 0xd8, 0x45, 0x6d, 0x68,
+	0xba, 0x46, 0x5a, 0x80, 0x20, 0x88, 0x55, 0x84, 0xac, 0xbe, 0x22, 0xda, 0x92, 0x80, 0x98, 0x24,
+	0xf6, 0xd0, 0x9a, 0xde, 0x54, 0x43, 0x1d, 0x80, 0x3a, 0x3a, 0x0c, 0x9c, 0xf7, 0x09, 0xad, 0xfd,
+	0x33, 0x2d, 0x78, 0x17, 0x75, 0xd8, 0x99, 0x18, 0xb0, 0x31, 0x8d, 0xd2, 0x2c, 0xa1, 0x13, 0xb8,
+	0x3a, 0x9d, 0xb0, 0xad, 0x82, 0x47, 0x65, 0x0c, 0xf7, 0x50, 0x53, 0x8f, 0x13, 0x59, 0x82, 0xab,
+	0xe5, 0x1a, 0xaf, 0xc5, 0xcd, 0x31, 0xac, 0x74, 0xde, 0x17, 0x0b, 0xdd, 0x99, 0x3b, 0x18, 0x78,
+	0x0b, 0xd9, 0x55, 0x95, 0x60, 0xfb, 0x7a, 0xd8, 0xd4, 0x15, 0xfa, 0x3f, 0xbf, 0xa5, 0x39, 0xf9,
+	0x6d, 0xa0, 0x15, 0x98, 0x4c, 0xb2, 0xec, 0x5a, 0xbe, 0x1d, 0xca, 0x05, 0xde, 0x51, 0x93, 0x95,
+	0xd3, 0x2c, 0x49, 0xb3, 0x21, 0xa9, 0xbb, 0x96, 0xdf, 0x94, 0xf3, 0xd2, 0x97, 0xa1, 0xde, 0xd3,
+	0xcb, 0xa9, 0x63, 0x5d, 0x4d, 0x1d, 0xeb, 0xd7, 0xd4, 0xb1, 0x3e, 0xcf, 0x9c, 0xda, 0xd5, 0xcc,
+	0xa9, 0xfd, 0x98, 0x39, 0xb5, 0x77, 0x5b, 0xd5, 0xeb, 0x33, 0xf9, 0xfb, 0xfd, 0x11, 0x1f, 0x73,
+	0xca, 0xdf, 0x37, 0xe0, 0x01, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x65, 0x39, 0xf9,
+	0x18, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +364,138 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TemplateIdSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TemplateIdSeq))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TradeIdSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TradeIdSeq))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.OrderIdSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrderIdSeq))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MarketIdSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MarketIdSeq))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for iNdEx := len(m.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LastTradePrices) > 0 {
+		for iNdEx := len(m.LastTradePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastTradePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -128,6 +509,94 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OutcomeIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutcomeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisLastTradePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisLastTradePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisLastTradePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TwapPending {
+		i--
+		if m.TwapPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OutcomeIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutcomeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -147,6 +616,102 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastTradePrices) > 0 {
+		for _, e := range m.LastTradePrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for _, e := range m.TwapRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MarketIdSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.MarketIdSeq))
+	}
+	if m.OrderIdSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.OrderIdSeq))
+	}
+	if m.TradeIdSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.TradeIdSeq))
+	}
+	if m.TemplateIdSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.TemplateIdSeq))
+	}
+	return n
+}
+
+func (m *GenesisPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutcomeIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.OutcomeIndex))
+	}
+	l = m.Position.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisLastTradePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovGenesis(uint64(m.MarketId))
+	}
+	if m.OutcomeIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.OutcomeIndex))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TwapPending {
+		n += 2
+	}
 	return n
 }
 
@@ -218,6 +783,596 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, PredictionMarket{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, GenesisPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTradePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastTradePrices = append(m.LastTradePrices, GenesisLastTradePrice{})
+			if err := m.LastTradePrices[len(m.LastTradePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecords = append(m.TwapRecords, TwapRecord{})
+			if err := m.TwapRecords[len(m.TwapRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, MarketGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, MarketTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIdSeq", wireType)
+			}
+			m.MarketIdSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketIdSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIdSeq", wireType)
+			}
+			m.OrderIdSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderIdSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIdSeq", wireType)
+			}
+			m.TradeIdSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIdSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateIdSeq", wireType)
+			}
+			m.TemplateIdSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TemplateIdSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutcomeIndex", wireType)
+			}
+			m.OutcomeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutcomeIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisLastTradePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisLastTradePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisLastTradePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutcomeIndex", wireType)
+			}
+			m.OutcomeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutcomeIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TwapPending = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 
 	"speculod/x/prediction/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +25,126 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc:     "populated genesis state",
+			genState: populatedGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "duplicate market id",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Markets = append(gs.Markets, gs.Markets[0])
+			}),
+		},
+		{
+			desc: "market sequence not above the market ids",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.MarketIdSeq = 1
+			}),
+		},
+		{
+			desc: "market of an unknown group",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Markets[0].GroupId = "sports"
+			}),
+		},
+		{
+			desc: "market from an unknown template",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Markets[1].TemplateId = 1
+			}),
+		},
+		{
+			desc: "template id ignored for a market not from a template",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Markets[0].TemplateId = 1
+			}),
+			valid: true,
+		},
+		{
+			desc: "duplicate order id",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Orders = append(gs.Orders, gs.Orders[0])
+			}),
+		},
+		{
+			desc: "order of an unknown market",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Orders[0].MarketId = 5
+			}),
+		},
+		{
+			desc: "order outcome out of range",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Orders[0].OutcomeIndex = 2
+			}),
+		},
+		{
+			desc: "order price out of range",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Orders[0].Price = "1.5"
+			}),
+		},
+		{
+			desc: "order sequence not above the order ids",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.OrderIdSeq = 0
+			}),
+		},
+		{
+			desc: "duplicate position",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Positions = append(gs.Positions, gs.Positions[0])
+			}),
+		},
+		{
+			desc: "position outcome out of range",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Positions[0].OutcomeIndex = 3
+			}),
+		},
+		{
+			desc: "trade sequence not above the trade ids",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.TradeIdSeq = 0
+			}),
+		},
+		{
+			desc: "trade of an unknown market",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Trades[0].MarketId = 5
+			}),
+		},
+		{
+			desc: "duplicate twap record",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.TwapRecords = append(gs.TwapRecords, gs.TwapRecords[0])
+			}),
+		},
+		{
+			desc: "duplicate group",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Groups = append(gs.Groups, gs.Groups[0])
+			}),
+		},
+		{
+			desc: "template sequence not above the template ids",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.TemplateIdSeq = 0
+			}),
+		},
+		{
+			desc: "template of an unknown group",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Templates[0].GroupId = "sports"
+			}),
+		},
+		{
+			desc: "template escrowing no bond",
+			genState: modifiedGenesis(func(gs *types.GenesisState) {
+				gs.Templates[0].BondEscrowed = true
+			}),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -36,3 +157,42 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+// populatedGenesis returns a valid genesis state with an entry in every collection
+func populatedGenesis() *types.GenesisState {
+	amount := sdk.NewInt64Coin("stake", 10)
+	return &types.GenesisState{
+		Params: types.DefaultParams(),
+		Markets: []types.PredictionMarket{
+			{Id: 0, Question: "Will it rain?", Outcomes: []string{"Yes", "No"}, Status: types.MarketStatusOpen, GroupId: "weather"},
+			{Id: 1, Question: "Will it snow?", Outcomes: []string{"Yes", "No"}, Status: types.MarketStatusClosed, FromTemplate: true},
+		},
+		Orders: []types.Order{
+			{Id: 0, MarketId: 1, OutcomeIndex: 1, Price: "0.5", Amount: &amount, Status: types.ORDER_STATUS_OPEN},
+		},
+		Positions: []types.GenesisPosition{
+			{OutcomeIndex: 0, Position: types.Position{MarketId: 0, Owner: "alice", Amount: &amount}},
+		},
+		Trades: []types.Trade{
+			{TradeId: 3, MarketId: 1, OutcomeIndex: 1, Price: "0.5", Amount: &amount},
+		},
+		LastTradePrices: []types.GenesisLastTradePrice{
+			{MarketId: 1, OutcomeIndex: 1, Price: "0.5"},
+		},
+		TwapRecords: []types.TwapRecord{
+			{MarketId: 1, OutcomeIndex: 1, Price: "0.5", CumulativePrice: "0", Timestamp: 100},
+		},
+		Groups:        []types.MarketGroup{{Id: "weather"}},
+		Templates:     []types.MarketTemplate{{Id: 0, Question: "Will it rain?", Outcomes: []string{"Yes", "No"}, GroupId: "weather"}},
+		MarketIdSeq:   2,
+		OrderIdSeq:    1,
+		TradeIdSeq:    4,
+		TemplateIdSeq: 1,
+	}
+}
+
+func modifiedGenesis(modify func(*types.GenesisState)) *types.GenesisState {
+	gs := populatedGenesis()
+	modify(gs)
+	return gs
+}
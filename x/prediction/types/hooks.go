@@ -0,0 +1,58 @@
+package types
+
+import "context"
+
+var _ PredictionHooks = MultiPredictionHooks{}
+
+// MultiPredictionHooks combines multiple prediction hooks, all hook functions are run in array sequence
+type MultiPredictionHooks []PredictionHooks
+
+// NewMultiPredictionHooks returns a MultiPredictionHooks running the given hooks in order
+func NewMultiPredictionHooks(hooks ...PredictionHooks) MultiPredictionHooks {
+	return hooks
+}
+
+func (h MultiPredictionHooks) AfterMarketCreated(ctx context.Context, market PredictionMarket) error {
+	for i := range h {
+		if err := h[i].AfterMarketCreated(ctx, market); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPredictionHooks) AfterOrderPosted(ctx context.Context, order Order) error {
+	for i := range h {
+		if err := h[i].AfterOrderPosted(ctx, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPredictionHooks) AfterTrade(ctx context.Context, trade Trade) error {
+	for i := range h {
+		if err := h[i].AfterTrade(ctx, trade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPredictionHooks) AfterMarketClosed(ctx context.Context, marketId uint64) error {
+	for i := range h {
+		if err := h[i].AfterMarketClosed(ctx, marketId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPredictionHooks) AfterMarketResolved(ctx context.Context, marketId uint64, outcome string) error {
+	for i := range h {
+		if err := h[i].AfterMarketResolved(ctx, marketId, outcome); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -17,3 +17,15 @@ const (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_prediction")
+
+// PositionsKey is the prefix of the positions collection
+var PositionsKey = collections.NewPrefix("positions")
+
+// TradesByOutcomeKey is the prefix of the index of trades by market and outcome
+var TradesByOutcomeKey = collections.NewPrefix("outcome_trades")
+
+// OpenMarketsByDeadlineKey is the prefix of the index of open markets by deadline
+var OpenMarketsByDeadlineKey = collections.NewPrefix("open_markets_by_deadline")
+
+// RestingOrdersKey is the prefix of the index of resting orders by market and outcome
+var RestingOrdersKey = collections.NewPrefix("resting_orders")
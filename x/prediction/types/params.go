@@ -1,17 +1,125 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(positionLimits PositionLimits, templateLimits TemplateLimits) Params {
+	return Params{
+		PositionLimits: positionLimits,
+		TemplateLimits: templateLimits,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(NoPositionLimits(), DefaultTemplateLimits())
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if err := p.PositionLimits.Validate(); err != nil {
+		return err
+	}
+	return p.TemplateLimits.Validate()
+}
 
+// DefaultTemplateLimits returns the template limits of a new chain: a bond of
+// 1000 of the bond denom, ten active templates per creator and a pause after
+// three unused markets.
+func DefaultTemplateLimits() TemplateLimits {
+	return TemplateLimits{
+		MinBond:             sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000),
+		MaxActivePerCreator: 10,
+		MaxIdleMarkets:      3,
+	}
+}
+
+// Validate checks that the minimum bond is a valid coin when set.
+func (l TemplateLimits) Validate() error {
+	if !l.HasMinBond() {
+		return nil
+	}
+	if err := l.MinBond.Validate(); err != nil {
+		return fmt.Errorf("invalid min template bond: %w", err)
+	}
 	return nil
 }
+
+// HasMinBond reports whether templates must escrow a bond.
+func (l TemplateLimits) HasMinBond() bool { return isIntLimit(l.MinBond.Amount) }
+
+// CoversMinBond reports whether bond is at least the minimum bond.
+func (l TemplateLimits) CoversMinBond(bond *sdk.Coin) bool {
+	if !l.HasMinBond() {
+		return true
+	}
+	return bond != nil && bond.Denom == l.MinBond.Denom && bond.Amount.GTE(l.MinBond.Amount)
+}
+
+// NoPositionLimits returns position limits that do not restrict trading.
+func NoPositionLimits() PositionLimits {
+	return PositionLimits{
+		MaxPositionPerOutcome: math.ZeroInt(),
+		MaxNotionalPerMarket:  math.LegacyZeroDec(),
+	}
+}
+
+// Validate checks that no limit is negative.
+func (l PositionLimits) Validate() error {
+	if !l.MaxPositionPerOutcome.IsNil() && l.MaxPositionPerOutcome.IsNegative() {
+		return fmt.Errorf("max position per outcome cannot be negative: %s", l.MaxPositionPerOutcome)
+	}
+	if !l.MaxNotionalPerMarket.IsNil() && l.MaxNotionalPerMarket.IsNegative() {
+		return fmt.Errorf("max notional per market cannot be negative: %s", l.MaxNotionalPerMarket)
+	}
+	return nil
+}
+
+// Override returns the limits with every limit set in o taking precedence.
+func (l PositionLimits) Override(o *PositionLimits) PositionLimits {
+	if o == nil {
+		return l
+	}
+	if isIntLimit(o.MaxPositionPerOutcome) {
+		l.MaxPositionPerOutcome = o.MaxPositionPerOutcome
+	}
+	if isDecLimit(o.MaxNotionalPerMarket) {
+		l.MaxNotionalPerMarket = o.MaxNotionalPerMarket
+	}
+	if o.MaxOpenOrdersPerAccount > 0 {
+		l.MaxOpenOrdersPerAccount = o.MaxOpenOrdersPerAccount
+	}
+	return l
+}
+
+// IsTighterThan reports whether every limit set in l is within the
+// corresponding limit of base, so that overriding base with l cannot loosen it.
+func (l PositionLimits) IsTighterThan(base PositionLimits) bool {
+	if isIntLimit(base.MaxPositionPerOutcome) && isIntLimit(l.MaxPositionPerOutcome) &&
+		l.MaxPositionPerOutcome.GT(base.MaxPositionPerOutcome) {
+		return false
+	}
+	if isDecLimit(base.MaxNotionalPerMarket) && isDecLimit(l.MaxNotionalPerMarket) &&
+		l.MaxNotionalPerMarket.GT(base.MaxNotionalPerMarket) {
+		return false
+	}
+	if base.MaxOpenOrdersPerAccount > 0 && l.MaxOpenOrdersPerAccount > base.MaxOpenOrdersPerAccount {
+		return false
+	}
+	return true
+}
+
+// HasPositionLimit reports whether the position per outcome is limited.
+func (l PositionLimits) HasPositionLimit() bool { return isIntLimit(l.MaxPositionPerOutcome) }
+
+// HasNotionalLimit reports whether the notional per market is limited.
+func (l PositionLimits) HasNotionalLimit() bool { return isDecLimit(l.MaxNotionalPerMarket) }
+
+func isIntLimit(i math.Int) bool { return !i.IsNil() && i.IsPositive() }
+
+func isDecLimit(d math.LegacyDec) bool { return !d.IsNil() && d.IsPositive() }
@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// position_limits are the default limits for every market.
+	PositionLimits PositionLimits `protobuf:"bytes,1,opt,name=position_limits,json=positionLimits,proto3" json:"position_limits"`
+	// template_limits bound the recurring market templates.
+	TemplateLimits TemplateLimits `protobuf:"bytes,2,opt,name=template_limits,json=templateLimits,proto3" json:"template_limits"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,8 +68,145 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetPositionLimits() PositionLimits {
+	if m != nil {
+		return m.PositionLimits
+	}
+	return PositionLimits{}
+}
+
+func (m *Params) GetTemplateLimits() TemplateLimits {
+	if m != nil {
+		return m.TemplateLimits
+	}
+	return TemplateLimits{}
+}
+
+// PositionLimits caps what a single account can hold in one market. A zero
+// value means no limit.
+type PositionLimits struct {
+	// max_position_per_outcome caps the net amount an account holds on a single
+	// outcome, long or short, counting its resting orders in the direction they
+	// would move it.
+	MaxPositionPerOutcome cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_position_per_outcome,json=maxPositionPerOutcome,proto3,customtype=cosmossdk.io/math.Int" json:"max_position_per_outcome"`
+	// max_notional_per_market caps the net cost of an account's positions plus
+	// the price-weighted value of its resting orders across all outcomes of a
+	// market.
+	MaxNotionalPerMarket cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_notional_per_market,json=maxNotionalPerMarket,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_notional_per_market"`
+	// max_open_orders_per_account caps the resting orders of an account in a market.
+	MaxOpenOrdersPerAccount uint32 `protobuf:"varint,3,opt,name=max_open_orders_per_account,json=maxOpenOrdersPerAccount,proto3" json:"max_open_orders_per_account,omitempty"`
+}
+
+func (m *PositionLimits) Reset()         { *m = PositionLimits{} }
+func (m *PositionLimits) String() string { return proto.CompactTextString(m) }
+func (*PositionLimits) ProtoMessage()    {}
+func (*PositionLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e61347e1c193ad, []int{1}
+}
+func (m *PositionLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionLimits.Merge(m, src)
+}
+func (m *PositionLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionLimits proto.InternalMessageInfo
+
+func (m *PositionLimits) GetMaxOpenOrdersPerAccount() uint32 {
+	if m != nil {
+		return m.MaxOpenOrdersPerAccount
+	}
+	return 0
+}
+
+// TemplateLimits bound the markets that templates keep creating. A zero value
+// means no limit.
+type TemplateLimits struct {
+	// min_bond is the least bond a template escrows for each of its markets.
+	MinBond types.Coin `protobuf:"bytes,1,opt,name=min_bond,json=minBond,proto3" json:"min_bond"`
+	// max_active_per_creator caps the active templates of an account.
+	MaxActivePerCreator uint32 `protobuf:"varint,2,opt,name=max_active_per_creator,json=maxActivePerCreator,proto3" json:"max_active_per_creator,omitempty"`
+	// max_idle_markets pauses a template once that many of its markets in a row
+	// had no trades by the time the next one was due.
+	MaxIdleMarkets uint32 `protobuf:"varint,3,opt,name=max_idle_markets,json=maxIdleMarkets,proto3" json:"max_idle_markets,omitempty"`
+}
+
+func (m *TemplateLimits) Reset()         { *m = TemplateLimits{} }
+func (m *TemplateLimits) String() string { return proto.CompactTextString(m) }
+func (*TemplateLimits) ProtoMessage()    {}
+func (*TemplateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e61347e1c193ad, []int{2}
+}
+func (m *TemplateLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TemplateLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TemplateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateLimits.Merge(m, src)
+}
+func (m *TemplateLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateLimits proto.InternalMessageInfo
+
+func (m *TemplateLimits) GetMinBond() types.Coin {
+	if m != nil {
+		return m.MinBond
+	}
+	return types.Coin{}
+}
+
+func (m *TemplateLimits) GetMaxActivePerCreator() uint32 {
+	if m != nil {
+		return m.MaxActivePerCreator
+	}
+	return 0
+}
+
+func (m *TemplateLimits) GetMaxIdleMarkets() uint32 {
+	if m != nil {
+		return m.MaxIdleMarkets
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "speculod.prediction.v1.Params")
+	proto.RegisterType((*PositionLimits)(nil), "speculod.prediction.v1.PositionLimits")
+	proto.RegisterType((*TemplateLimits)(nil), "speculod.prediction.v1.TemplateLimits")
 }
 
 func init() {
@@ -70,18 +214,42 @@ func init() {
 }
 
 var fileDescriptor_95e61347e1c193ad = []byte{
-	// 163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x2e, 0x48, 0x4d,
-	0x2e, 0xcd, 0xc9, 0x4f, 0xd1, 0x2f, 0x28, 0x4a, 0x4d, 0xc9, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x29, 0xd2, 0x43, 0x28, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb,
-	0xd7, 0x07, 0x93, 0x10, 0xa5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05,
-	0x11, 0x55, 0xd2, 0xe7, 0x62, 0x0b, 0x00, 0x1b, 0x68, 0xa5, 0xfa, 0x62, 0x81, 0x3c, 0x63, 0xd7,
-	0xf3, 0x0d, 0x5a, 0x32, 0x70, 0x8b, 0x2b, 0x90, 0xad, 0x86, 0x28, 0x73, 0x32, 0x3d, 0xf1, 0x48,
-	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x69, 0xec, 0xfa, 0x4a, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0xd6, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xe8, 0x36, 0xc3, 0xd6,
-	0x00, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0xf6, 0xf7, 0xa3, 0xda, 0x91, 0x46, 0x5d, 0xdb, 0xda, 0x3f, 0xb2, 0x2d, 0x15, 0xa5,
+	0x14, 0xdc, 0x35, 0x16, 0x3d, 0x14, 0x41, 0x9a, 0xf6, 0x52, 0xa8, 0x76, 0x09, 0x9e, 0x7a, 0x59,
+	0x26, 0xb3, 0x2f, 0x71, 0xe8, 0xce, 0xcc, 0x32, 0x33, 0x09, 0xdb, 0xaf, 0xe0, 0xc9, 0x8f, 0xe0,
+	0xd1, 0x93, 0xf4, 0x20, 0x7e, 0x86, 0x1e, 0x8b, 0x27, 0xf1, 0x50, 0x24, 0x39, 0xc4, 0x8f, 0x21,
+	0xf3, 0x27, 0x35, 0xc1, 0xe0, 0x25, 0x64, 0xe6, 0x79, 0xf2, 0x3c, 0xcf, 0xfb, 0xcc, 0x1b, 0xf4,
+	0x50, 0x95, 0x40, 0xba, 0x85, 0xc8, 0x93, 0x52, 0x42, 0x4e, 0x89, 0xa6, 0x82, 0x27, 0xbd, 0x46,
+	0x52, 0x62, 0x89, 0x99, 0x8a, 0x4b, 0x29, 0xb4, 0x08, 0x97, 0x46, 0xa4, 0xf8, 0x0f, 0x29, 0xee,
+	0x35, 0x56, 0xef, 0x62, 0x46, 0xb9, 0x48, 0xec, 0xa7, 0xa3, 0xae, 0x46, 0x44, 0x28, 0x26, 0x54,
+	0xd2, 0xc6, 0x0a, 0x92, 0x5e, 0xa3, 0x0d, 0x1a, 0x37, 0x12, 0x22, 0x28, 0xf7, 0xf8, 0x8a, 0xc3,
+	0x33, 0x7b, 0x4a, 0xdc, 0xc1, 0x43, 0x0b, 0x1d, 0xd1, 0x11, 0xee, 0xde, 0x7c, 0x73, 0xb7, 0x9b,
+	0xc3, 0x00, 0xcd, 0xa6, 0x36, 0x4c, 0x78, 0x82, 0x6e, 0x97, 0x42, 0x51, 0xe3, 0x9e, 0x15, 0x94,
+	0x51, 0xad, 0x96, 0x83, 0x8d, 0x60, 0xeb, 0xd6, 0xb3, 0xc7, 0xf1, 0xf4, 0x80, 0x71, 0xea, 0xe9,
+	0x47, 0x96, 0xdd, 0x9c, 0xbb, 0xb8, 0x5a, 0xaf, 0x7d, 0x1a, 0x9e, 0x6f, 0x07, 0xad, 0x7a, 0x39,
+	0x01, 0x19, 0x6d, 0x0d, 0xac, 0x2c, 0xb0, 0x86, 0x91, 0xf6, 0xcc, 0xbf, 0xb5, 0xdf, 0x7a, 0xfa,
+	0x14, 0x6d, 0x3d, 0x01, 0xed, 0x3e, 0xfa, 0xf5, 0x71, 0x3d, 0x78, 0x3f, 0x3c, 0xdf, 0x7e, 0x70,
+	0x5d, 0x76, 0x35, 0x5e, 0xb7, 0x1b, 0x6f, 0xf3, 0xf3, 0x0c, 0xaa, 0x4f, 0x06, 0x0e, 0x29, 0x5a,
+	0x66, 0xb8, 0xca, 0xae, 0xa7, 0x2e, 0x41, 0x66, 0xa2, 0xab, 0x89, 0x60, 0x60, 0x47, 0x9f, 0x6b,
+	0x3e, 0x35, 0xb6, 0x3f, 0xae, 0xd6, 0x17, 0x5d, 0x95, 0x2a, 0x3f, 0x8d, 0xa9, 0x48, 0x18, 0xd6,
+	0xef, 0xe2, 0x43, 0xae, 0xbf, 0x7d, 0x79, 0x82, 0x7c, 0xc7, 0x87, 0x5c, 0xbb, 0x74, 0x8b, 0x0c,
+	0x57, 0x23, 0x9b, 0x14, 0xe4, 0xb1, 0x93, 0x0b, 0x19, 0xba, 0x6f, 0xac, 0xb8, 0x30, 0xf7, 0xb8,
+	0xb0, 0x56, 0x0c, 0xcb, 0x53, 0xd0, 0xb6, 0x88, 0xb9, 0xe6, 0x0b, 0xef, 0xb4, 0xf6, 0xb7, 0xd3,
+	0x11, 0x74, 0x30, 0x39, 0x3b, 0x00, 0x32, 0xe6, 0x77, 0x00, 0xc4, 0xf9, 0x2d, 0x30, 0x5c, 0xbd,
+	0xf1, 0xaa, 0x29, 0xc8, 0xd7, 0x56, 0x33, 0x7c, 0x89, 0xd6, 0x8c, 0x9d, 0x28, 0x81, 0x67, 0x42,
+	0xe6, 0x20, 0x95, 0x75, 0xc4, 0x84, 0x88, 0x2e, 0xd7, 0xcb, 0xff, 0x6d, 0x04, 0x5b, 0xf3, 0x2d,
+	0x93, 0xe8, 0xb8, 0x04, 0x7e, 0x6c, 0x09, 0x29, 0xc8, 0x3d, 0x07, 0xef, 0xfe, 0x6f, 0x1a, 0xdd,
+	0xfc, 0x1a, 0xa0, 0xfa, 0xe4, 0x2b, 0x84, 0xaf, 0xd0, 0x4d, 0x46, 0x79, 0xd6, 0x16, 0x3c, 0xf7,
+	0xbb, 0xb1, 0x12, 0xfb, 0x40, 0x66, 0x23, 0x63, 0xbf, 0x91, 0xf1, 0xbe, 0xa0, 0x7c, 0xfc, 0xc9,
+	0x6e, 0x30, 0xca, 0x9b, 0x82, 0xe7, 0xe1, 0x0e, 0x5a, 0x32, 0xb9, 0x30, 0xd1, 0xb4, 0x07, 0x36,
+	0x12, 0x91, 0x80, 0xb5, 0x90, 0xb6, 0x85, 0xf9, 0xd6, 0x3d, 0x86, 0xab, 0x3d, 0x0b, 0xa6, 0x20,
+	0xf7, 0x1d, 0x14, 0x6e, 0xa1, 0x3b, 0xe6, 0x47, 0x34, 0x2f, 0xc0, 0x77, 0xa6, 0xfc, 0x04, 0x75,
+	0x86, 0xab, 0xc3, 0xbc, 0x00, 0x37, 0xb5, 0x72, 0xc1, 0x9b, 0xcf, 0x2f, 0xfa, 0x51, 0x70, 0xd9,
+	0x8f, 0x82, 0x9f, 0xfd, 0x28, 0xf8, 0x30, 0x88, 0x6a, 0x97, 0x83, 0xa8, 0xf6, 0x7d, 0x10, 0xd5,
+	0x4e, 0xd6, 0xa6, 0x6f, 0x88, 0x3e, 0x2b, 0x41, 0xb5, 0x67, 0xed, 0x3f, 0x62, 0xe7, 0x77, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x0b, 0x64, 0xc8, 0xea, 0xb4, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +271,72 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.PositionLimits.Equal(&that1.PositionLimits) {
+		return false
+	}
+	if !this.TemplateLimits.Equal(&that1.TemplateLimits) {
+		return false
+	}
+	return true
+}
+func (this *PositionLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PositionLimits)
+	if !ok {
+		that2, ok := that.(PositionLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxPositionPerOutcome.Equal(that1.MaxPositionPerOutcome) {
+		return false
+	}
+	if !this.MaxNotionalPerMarket.Equal(that1.MaxNotionalPerMarket) {
+		return false
+	}
+	if this.MaxOpenOrdersPerAccount != that1.MaxOpenOrdersPerAccount {
+		return false
+	}
+	return true
+}
+func (this *TemplateLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TemplateLimits)
+	if !ok {
+		that2, ok := that.(TemplateLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MinBond.Equal(&that1.MinBond) {
+		return false
+	}
+	if this.MaxActivePerCreator != that1.MaxActivePerCreator {
+		return false
+	}
+	if this.MaxIdleMarkets != that1.MaxIdleMarkets {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +359,117 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TemplateLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PositionLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxOpenOrdersPerAccount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOpenOrdersPerAccount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxNotionalPerMarket.Size()
+		i -= size
+		if _, err := m.MaxNotionalPerMarket.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxPositionPerOutcome.Size()
+		i -= size
+		if _, err := m.MaxPositionPerOutcome.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxIdleMarkets != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIdleMarkets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxActivePerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActivePerCreator))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MinBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +490,43 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.PositionLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TemplateLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PositionLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxPositionPerOutcome.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxNotionalPerMarket.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxOpenOrdersPerAccount != 0 {
+		n += 1 + sovParams(uint64(m.MaxOpenOrdersPerAccount))
+	}
+	return n
+}
+
+func (m *TemplateLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxActivePerCreator != 0 {
+		n += 1 + sovParams(uint64(m.MaxActivePerCreator))
+	}
+	if m.MaxIdleMarkets != 0 {
+		n += 1 + sovParams(uint64(m.MaxIdleMarkets))
+	}
 	return n
 }
 
@@ -183,6 +565,330 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TemplateLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionPerOutcome", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPositionPerOutcome.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNotionalPerMarket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNotionalPerMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOrdersPerAccount", wireType)
+			}
+			m.MaxOpenOrdersPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOrdersPerAccount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActivePerCreator", wireType)
+			}
+			m.MaxActivePerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActivePerCreator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIdleMarkets", wireType)
+			}
+			m.MaxIdleMarkets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIdleMarkets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
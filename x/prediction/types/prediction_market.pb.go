@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -34,6 +35,14 @@ type PredictionMarket struct {
 	CreatedAt    int64    `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	TotalPool    int64    `protobuf:"varint,9,opt,name=total_pool,json=totalPool,proto3" json:"total_pool,omitempty"`
 	OutcomePools []string `protobuf:"bytes,10,rep,name=outcome_pools,json=outcomePools,proto3" json:"outcome_pools,omitempty"`
+	// position_limits overrides the module limits for this market, field by field.
+	PositionLimits *PositionLimits `protobuf:"bytes,11,opt,name=position_limits,json=positionLimits,proto3" json:"position_limits,omitempty"`
+	// creator_bond is held by the module until the market is resolved.
+	CreatorBond *types.Coin `protobuf:"bytes,12,opt,name=creator_bond,json=creatorBond,proto3" json:"creator_bond,omitempty"`
+	// template_id is the template the market was created from, valid when
+	// from_template is set.
+	TemplateId   uint64 `protobuf:"varint,13,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	FromTemplate bool   `protobuf:"varint,14,opt,name=from_template,json=fromTemplate,proto3" json:"from_template,omitempty"`
 }
 
 func (m *PredictionMarket) Reset()         { *m = PredictionMarket{} }
@@ -139,6 +148,34 @@ func (m *PredictionMarket) GetOutcomePools() []string {
 	return nil
 }
 
+func (m *PredictionMarket) GetPositionLimits() *PositionLimits {
+	if m != nil {
+		return m.PositionLimits
+	}
+	return nil
+}
+
+func (m *PredictionMarket) GetCreatorBond() *types.Coin {
+	if m != nil {
+		return m.CreatorBond
+	}
+	return nil
+}
+
+func (m *PredictionMarket) GetTemplateId() uint64 {
+	if m != nil {
+		return m.TemplateId
+	}
+	return 0
+}
+
+func (m *PredictionMarket) GetFromTemplate() bool {
+	if m != nil {
+		return m.FromTemplate
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*PredictionMarket)(nil), "speculod.prediction.v1.PredictionMarket")
 }
@@ -148,26 +185,35 @@ func init() {
 }
 
 var fileDescriptor_aef2310ad3abc47c = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xeb, 0xb4, 0x5f, 0xff, 0x58, 0x1f, 0x08, 0x79, 0xa8, 0x0c, 0x08, 0x2b, 0x82, 0xa5,
-	0x53, 0xaa, 0x0a, 0xf1, 0x00, 0xb0, 0x31, 0x20, 0x55, 0x19, 0x59, 0x22, 0x13, 0x5b, 0xc8, 0x22,
-	0xed, 0x35, 0xf6, 0x4d, 0x05, 0x6f, 0xc1, 0x53, 0xf0, 0x2c, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x22,
-	0x28, 0x6e, 0x1a, 0xba, 0xf9, 0x77, 0xce, 0xb9, 0x47, 0xb6, 0x2f, 0x4d, 0xbc, 0xd5, 0x79, 0x59,
-	0x80, 0x9a, 0x5b, 0xa7, 0x95, 0xc9, 0xd1, 0xc0, 0x7a, 0xbe, 0x59, 0x1c, 0x50, 0xb6, 0x92, 0xee,
-	0x45, 0x63, 0x62, 0x1d, 0x20, 0xb0, 0xe9, 0x3e, 0x9f, 0xfc, 0x25, 0x92, 0xcd, 0xe2, 0xf2, 0x33,
-	0xa2, 0x27, 0xcb, 0x4e, 0x79, 0x08, 0x23, 0xec, 0x98, 0x46, 0x46, 0x71, 0x12, 0x93, 0xd9, 0x20,
-	0x8d, 0x8c, 0x62, 0x67, 0x74, 0xfc, 0x5a, 0x6a, 0xdf, 0x24, 0x78, 0x14, 0x93, 0xd9, 0x24, 0xed,
-	0xb8, 0xf1, 0xa0, 0xc4, 0x1c, 0x56, 0xda, 0xf3, 0x7e, 0xdc, 0x6f, 0xbc, 0x3d, 0xb3, 0x53, 0x3a,
-	0x7e, 0x76, 0x50, 0xda, 0xcc, 0x28, 0x3e, 0x08, 0x73, 0xa3, 0xc0, 0xf7, 0xa1, 0x52, 0x69, 0xa9,
-	0x0a, 0xb3, 0xd6, 0xfc, 0x5f, 0x4c, 0x66, 0xfd, 0xb4, 0x63, 0x36, 0xa5, 0x43, 0x8f, 0x12, 0x4b,
-	0xcf, 0x87, 0x61, 0xa8, 0x25, 0xc6, 0xe9, 0x28, 0x77, 0x5a, 0x22, 0x38, 0x3e, 0xda, 0xb5, 0xb5,
-	0xc8, 0x2e, 0x28, 0x0d, 0x47, 0xad, 0x32, 0x89, 0x7c, 0x1c, 0xfa, 0x26, 0xad, 0x72, 0x8b, 0x8d,
-	0x8d, 0x80, 0xb2, 0xc8, 0x2c, 0x40, 0xc1, 0x27, 0x3b, 0x3b, 0x28, 0x4b, 0x80, 0x82, 0x5d, 0xd1,
-	0xa3, 0xf6, 0xca, 0x21, 0xe0, 0x39, 0x0d, 0xef, 0xf8, 0xdf, 0x8a, 0x4d, 0xc6, 0xdf, 0xdd, 0x7c,
-	0x55, 0x82, 0x6c, 0x2b, 0x41, 0x7e, 0x2a, 0x41, 0x3e, 0x6a, 0xd1, 0xdb, 0xd6, 0xa2, 0xf7, 0x5d,
-	0x8b, 0xde, 0xe3, 0x79, 0xb7, 0x8a, 0xb7, 0xc3, 0x65, 0xe0, 0xbb, 0xd5, 0xfe, 0x69, 0x18, 0xbe,
-	0xff, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xe1, 0x44, 0x03, 0xb0, 0x01, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0xeb, 0x76, 0xe9, 0x1f, 0xb7, 0x5b, 0x90, 0x0f, 0x2b, 0x6f, 0x11, 0x21, 0x62, 0x25,
+	0x94, 0x93, 0xa3, 0x82, 0xb8, 0x71, 0x61, 0x39, 0xad, 0x04, 0xa2, 0x8a, 0x38, 0x71, 0x89, 0xdc,
+	0xd8, 0x20, 0x8b, 0x24, 0x63, 0x62, 0xa7, 0x82, 0xb7, 0xe0, 0xb1, 0x38, 0xee, 0x91, 0x23, 0x6a,
+	0x1f, 0x83, 0x0b, 0xb2, 0xe3, 0x86, 0x22, 0xb1, 0xb7, 0x7c, 0xdf, 0xfc, 0x66, 0x6c, 0xe7, 0x1b,
+	0xcc, 0x8c, 0x96, 0x45, 0x5b, 0x82, 0x48, 0x75, 0x23, 0x85, 0x2a, 0xac, 0x82, 0x3a, 0xdd, 0xad,
+	0x4f, 0x54, 0x5e, 0xf1, 0xe6, 0xb3, 0xb4, 0x4c, 0x37, 0x60, 0x81, 0x5c, 0x1c, 0x79, 0xf6, 0x97,
+	0x60, 0xbb, 0xf5, 0x2a, 0x2a, 0xc0, 0x54, 0x60, 0xd2, 0x2d, 0x37, 0x32, 0xdd, 0xad, 0xb7, 0xd2,
+	0xf2, 0x75, 0x5a, 0x80, 0xaa, 0xbb, 0xbe, 0xd5, 0xd5, 0x5d, 0xe7, 0xf0, 0x86, 0x57, 0xa6, 0x83,
+	0x9e, 0xfc, 0x1e, 0xe1, 0x07, 0x9b, 0xbe, 0xfc, 0xd6, 0x9f, 0x4b, 0x96, 0x78, 0xa8, 0x04, 0x45,
+	0x31, 0x4a, 0xce, 0xb2, 0xa1, 0x12, 0x64, 0x85, 0xa7, 0x5f, 0x5a, 0x69, 0x1c, 0x41, 0x87, 0x31,
+	0x4a, 0x66, 0x59, 0xaf, 0x5d, 0x0d, 0x5a, 0x5b, 0x40, 0x25, 0x0d, 0x1d, 0xc5, 0x23, 0x57, 0x3b,
+	0x6a, 0x72, 0x89, 0xa7, 0x9f, 0x1a, 0x68, 0x75, 0xae, 0x04, 0x3d, 0xf3, 0x7d, 0x13, 0xaf, 0x6f,
+	0xfc, 0x48, 0x21, 0xb9, 0x28, 0x55, 0x2d, 0xe9, 0xbd, 0x18, 0x25, 0xa3, 0xac, 0xd7, 0xe4, 0x02,
+	0x8f, 0x8d, 0xe5, 0xb6, 0x35, 0x74, 0xec, 0x9b, 0x82, 0x22, 0x14, 0x4f, 0x8a, 0x46, 0x72, 0x0b,
+	0x0d, 0x9d, 0x74, 0xd3, 0x82, 0x24, 0x8f, 0x30, 0xf6, 0x9f, 0x52, 0xe4, 0xdc, 0xd2, 0xa9, 0x9f,
+	0x37, 0x0b, 0xce, 0x2b, 0xeb, 0xca, 0x16, 0x2c, 0x2f, 0x73, 0x0d, 0x50, 0xd2, 0x59, 0x57, 0xf6,
+	0xce, 0x06, 0xa0, 0x24, 0x57, 0xf8, 0x3c, 0x5c, 0xd9, 0x03, 0x86, 0x62, 0xff, 0x8e, 0x45, 0x30,
+	0x1d, 0x63, 0xc8, 0x3b, 0x7c, 0x5f, 0x83, 0x51, 0x3e, 0x9e, 0x52, 0x55, 0xca, 0x1a, 0x3a, 0x8f,
+	0x51, 0x32, 0x7f, 0xf6, 0x94, 0xfd, 0x3f, 0x1f, 0xb6, 0x09, 0xf8, 0x1b, 0x4f, 0x67, 0x4b, 0xfd,
+	0x8f, 0x26, 0x2f, 0xf1, 0x22, 0x5c, 0x3f, 0xdf, 0x42, 0x2d, 0xe8, 0xc2, 0x4f, 0xbb, 0x64, 0x5d,
+	0xaa, 0xcc, 0xa5, 0xca, 0x42, 0xaa, 0xec, 0x35, 0xa8, 0x3a, 0x9b, 0x07, 0xfc, 0x1a, 0x6a, 0x41,
+	0x1e, 0xe3, 0xb9, 0x95, 0x95, 0x2e, 0xb9, 0x95, 0xee, 0xef, 0x9e, 0xfb, 0xac, 0xf0, 0xd1, 0xba,
+	0x11, 0xee, 0x51, 0x1f, 0x1b, 0xa8, 0xf2, 0xa3, 0x45, 0x97, 0x31, 0x4a, 0xa6, 0xd9, 0xc2, 0x99,
+	0xef, 0x83, 0x77, 0xfd, 0xe2, 0xc7, 0x3e, 0x42, 0xb7, 0xfb, 0x08, 0xfd, 0xda, 0x47, 0xe8, 0xfb,
+	0x21, 0x1a, 0xdc, 0x1e, 0xa2, 0xc1, 0xcf, 0x43, 0x34, 0xf8, 0xf0, 0xb0, 0x5f, 0x9e, 0xaf, 0xa7,
+	0xeb, 0x63, 0xbf, 0x69, 0x69, 0xb6, 0x63, 0xbf, 0x3b, 0xcf, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
+	0xcd, 0x53, 0x28, 0x1e, 0xca, 0x02, 0x00, 0x00,
 }
 
 func (m *PredictionMarket) Marshal() (dAtA []byte, err error) {
@@ -190,6 +236,45 @@ func (m *PredictionMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FromTemplate {
+		i--
+		if m.FromTemplate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TemplateId != 0 {
+		i = encodeVarintPredictionMarket(dAtA, i, uint64(m.TemplateId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreatorBond != nil {
+		{
+			size, err := m.CreatorBond.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPredictionMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.PositionLimits != nil {
+		{
+			size, err := m.PositionLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPredictionMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.OutcomePools) > 0 {
 		for iNdEx := len(m.OutcomePools) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.OutcomePools[iNdEx])
@@ -316,6 +401,20 @@ func (m *PredictionMarket) Size() (n int) {
 			n += 1 + l + sovPredictionMarket(uint64(l))
 		}
 	}
+	if m.PositionLimits != nil {
+		l = m.PositionLimits.Size()
+		n += 1 + l + sovPredictionMarket(uint64(l))
+	}
+	if m.CreatorBond != nil {
+		l = m.CreatorBond.Size()
+		n += 1 + l + sovPredictionMarket(uint64(l))
+	}
+	if m.TemplateId != 0 {
+		n += 1 + sovPredictionMarket(uint64(m.TemplateId))
+	}
+	if m.FromTemplate {
+		n += 2
+	}
 	return n
 }
 
@@ -622,6 +721,117 @@ func (m *PredictionMarket) Unmarshal(dAtA []byte) error {
 			}
 			m.OutcomePools = append(m.OutcomePools, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredictionMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPredictionMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredictionMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PositionLimits == nil {
+				m.PositionLimits = &PositionLimits{}
+			}
+			if err := m.PositionLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredictionMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPredictionMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredictionMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatorBond == nil {
+				m.CreatorBond = &types.Coin{}
+			}
+			if err := m.CreatorBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateId", wireType)
+			}
+			m.TemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredictionMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTemplate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredictionMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromTemplate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPredictionMarket(dAtA[iNdEx:])
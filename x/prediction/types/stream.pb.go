@@ -0,0 +1,1111 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: speculod/prediction/v1/stream.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamSubscription selects one market outcome.
+type StreamSubscription struct {
+	MarketId     uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OutcomeIndex uint32 `protobuf:"varint,2,opt,name=outcome_index,json=outcomeIndex,proto3" json:"outcome_index,omitempty"`
+}
+
+func (m *StreamSubscription) Reset()         { *m = StreamSubscription{} }
+func (m *StreamSubscription) String() string { return proto.CompactTextString(m) }
+func (*StreamSubscription) ProtoMessage()    {}
+func (*StreamSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c088d8d3e070161, []int{0}
+}
+func (m *StreamSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamSubscription.Merge(m, src)
+}
+func (m *StreamSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamSubscription proto.InternalMessageInfo
+
+func (m *StreamSubscription) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *StreamSubscription) GetOutcomeIndex() uint32 {
+	if m != nil {
+		return m.OutcomeIndex
+	}
+	return 0
+}
+
+// StreamOrderBookRequest is request type for the Stream/OrderBookUpdates RPC method.
+type StreamOrderBookRequest struct {
+	Subscriptions []StreamSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions"`
+}
+
+func (m *StreamOrderBookRequest) Reset()         { *m = StreamOrderBookRequest{} }
+func (m *StreamOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderBookRequest) ProtoMessage()    {}
+func (*StreamOrderBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c088d8d3e070161, []int{1}
+}
+func (m *StreamOrderBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderBookRequest.Merge(m, src)
+}
+func (m *StreamOrderBookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderBookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamOrderBookRequest proto.InternalMessageInfo
+
+func (m *StreamOrderBookRequest) GetSubscriptions() []StreamSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+// StreamOrderBookUpdate carries the changes to one market outcome.
+type StreamOrderBookUpdate struct {
+	// height is the block the update was committed in.
+	Height       int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	MarketId     uint64 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OutcomeIndex uint32 `protobuf:"varint,3,opt,name=outcome_index,json=outcomeIndex,proto3" json:"outcome_index,omitempty"`
+	// snapshot is set on the first update of every subscription, whose bids and
+	// asks hold the full book rather than a diff.
+	Snapshot bool `protobuf:"varint,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// bids and asks hold the levels that changed. A level with a zero amount
+	// and order count has been removed.
+	Bids []OrderBookEntry `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids"`
+	Asks []OrderBookEntry `protobuf:"bytes,6,rep,name=asks,proto3" json:"asks"`
+	// trades are the trades executed on the outcome in the block.
+	Trades []Trade `protobuf:"bytes,7,rep,name=trades,proto3" json:"trades"`
+}
+
+func (m *StreamOrderBookUpdate) Reset()         { *m = StreamOrderBookUpdate{} }
+func (m *StreamOrderBookUpdate) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderBookUpdate) ProtoMessage()    {}
+func (*StreamOrderBookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c088d8d3e070161, []int{2}
+}
+func (m *StreamOrderBookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderBookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderBookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamOrderBookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderBookUpdate.Merge(m, src)
+}
+func (m *StreamOrderBookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderBookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderBookUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamOrderBookUpdate proto.InternalMessageInfo
+
+func (m *StreamOrderBookUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StreamOrderBookUpdate) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *StreamOrderBookUpdate) GetOutcomeIndex() uint32 {
+	if m != nil {
+		return m.OutcomeIndex
+	}
+	return 0
+}
+
+func (m *StreamOrderBookUpdate) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
+}
+
+func (m *StreamOrderBookUpdate) GetBids() []OrderBookEntry {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *StreamOrderBookUpdate) GetAsks() []OrderBookEntry {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+func (m *StreamOrderBookUpdate) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StreamSubscription)(nil), "speculod.prediction.v1.StreamSubscription")
+	proto.RegisterType((*StreamOrderBookRequest)(nil), "speculod.prediction.v1.StreamOrderBookRequest")
+	proto.RegisterType((*StreamOrderBookUpdate)(nil), "speculod.prediction.v1.StreamOrderBookUpdate")
+}
+
+func init() {
+	proto.RegisterFile("speculod/prediction/v1/stream.proto", fileDescriptor_6c088d8d3e070161)
+}
+
+var fileDescriptor_6c088d8d3e070161 = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x8b, 0xda, 0x40,
+	0x18, 0xc6, 0x33, 0x9a, 0xa6, 0x76, 0x5a, 0xa1, 0x0c, 0xad, 0x84, 0x48, 0x63, 0x88, 0x50, 0x42,
+	0xa1, 0x49, 0xb5, 0xf4, 0xd4, 0x4b, 0x11, 0x7a, 0xf0, 0x54, 0x88, 0xad, 0x87, 0x5e, 0x24, 0x66,
+	0x06, 0x0d, 0xd6, 0xcc, 0x74, 0x66, 0x22, 0x7a, 0xe8, 0x77, 0xe8, 0xc7, 0xf2, 0x54, 0x3c, 0xf6,
+	0xb4, 0x2c, 0xfa, 0x45, 0x96, 0xfc, 0x59, 0xd7, 0x3f, 0x9b, 0xdd, 0x65, 0x6f, 0x33, 0x2f, 0xbf,
+	0xf7, 0x99, 0xe7, 0x99, 0xf7, 0x85, 0x6d, 0xc1, 0x48, 0x98, 0xfc, 0xa2, 0xd8, 0x63, 0x9c, 0xe0,
+	0x28, 0x94, 0x11, 0x8d, 0xbd, 0x45, 0xc7, 0x13, 0x92, 0x93, 0x60, 0xee, 0x32, 0x4e, 0x25, 0x45,
+	0x8d, 0x6b, 0xc8, 0xbd, 0x81, 0xdc, 0x45, 0xc7, 0x78, 0x35, 0xa1, 0x13, 0x9a, 0x21, 0x5e, 0x7a,
+	0xca, 0x69, 0xc3, 0x2e, 0x91, 0xa4, 0x1c, 0x13, 0x5e, 0x30, 0xad, 0x12, 0x46, 0x2e, 0x73, 0xc0,
+	0x1e, 0x42, 0x34, 0xc8, 0x2c, 0x0c, 0x92, 0xb1, 0x08, 0x79, 0xc4, 0x52, 0x02, 0x35, 0xe1, 0xb3,
+	0x79, 0xc0, 0x67, 0x44, 0x8e, 0x22, 0xac, 0x03, 0x0b, 0x38, 0xaa, 0x5f, 0xcb, 0x0b, 0x7d, 0x8c,
+	0xda, 0xb0, 0x4e, 0x13, 0x19, 0xd2, 0x39, 0x19, 0x45, 0x31, 0x26, 0x4b, 0xbd, 0x62, 0x01, 0xa7,
+	0xee, 0xbf, 0x28, 0x8a, 0xfd, 0xb4, 0x66, 0x33, 0xd8, 0xc8, 0x75, 0xbf, 0xa5, 0x6e, 0x7a, 0x94,
+	0xce, 0x7c, 0xf2, 0x3b, 0x21, 0x42, 0xa2, 0x21, 0xac, 0x8b, 0x83, 0xb7, 0x84, 0x0e, 0xac, 0xaa,
+	0xf3, 0xbc, 0xfb, 0xce, 0xbd, 0x3d, 0xbc, 0x7b, 0x6e, 0xaf, 0xa7, 0xae, 0x2f, 0x5a, 0x8a, 0x7f,
+	0x2c, 0x63, 0xff, 0xab, 0xc0, 0xd7, 0x27, 0x4f, 0xfe, 0x60, 0x38, 0x90, 0x04, 0x35, 0xa0, 0x36,
+	0x25, 0xd1, 0x64, 0x2a, 0xb3, 0x28, 0x55, 0xbf, 0xb8, 0x1d, 0xa7, 0xac, 0xdc, 0x97, 0xb2, 0x7a,
+	0x9e, 0x12, 0x19, 0xb0, 0x26, 0xe2, 0x80, 0x89, 0x29, 0x95, 0xba, 0x6a, 0x01, 0xa7, 0xe6, 0xef,
+	0xef, 0xe8, 0x0b, 0x54, 0xc7, 0x11, 0x16, 0xfa, 0x93, 0x2c, 0xde, 0xdb, 0xb2, 0x78, 0x7b, 0xb3,
+	0x5f, 0x63, 0xc9, 0x57, 0x45, 0xb4, 0xac, 0x33, 0x55, 0x08, 0xc4, 0x4c, 0xe8, 0xda, 0x63, 0x14,
+	0xd2, 0x4e, 0xf4, 0x19, 0x6a, 0x92, 0x07, 0x98, 0x08, 0xfd, 0x69, 0xa6, 0xf1, 0xa6, 0x4c, 0xe3,
+	0x7b, 0x4a, 0x15, 0xad, 0x45, 0x4b, 0xf7, 0x0f, 0xd4, 0xf2, 0xff, 0x44, 0x02, 0xbe, 0x3c, 0xf9,
+	0x53, 0x81, 0xdc, 0xbb, 0xe7, 0x75, 0x3a, 0x76, 0xe3, 0xfd, 0x03, 0xf9, 0x5c, 0xff, 0x03, 0xe8,
+	0x7d, 0x5a, 0x6f, 0x4d, 0xb0, 0xd9, 0x9a, 0xe0, 0x72, 0x6b, 0x82, 0xbf, 0x3b, 0x53, 0xd9, 0xec,
+	0x4c, 0xe5, 0xff, 0xce, 0x54, 0x7e, 0x36, 0xf7, 0x4b, 0xbd, 0x3c, 0x5c, 0x6b, 0xb9, 0x62, 0x44,
+	0x8c, 0xb5, 0x6c, 0xaf, 0x3f, 0x5e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x08, 0xbd, 0x24, 0xeb, 0x71,
+	0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	// OrderBookUpdates streams order book diffs and trades for the subscribed
+	// market outcomes, once per committed block in which they changed.
+	OrderBookUpdates(ctx context.Context, in *StreamOrderBookRequest, opts ...grpc.CallOption) (Stream_OrderBookUpdatesClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) OrderBookUpdates(ctx context.Context, in *StreamOrderBookRequest, opts ...grpc.CallOption) (Stream_OrderBookUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/speculod.prediction.v1.Stream/OrderBookUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamOrderBookUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_OrderBookUpdatesClient interface {
+	Recv() (*StreamOrderBookUpdate, error)
+	grpc.ClientStream
+}
+
+type streamOrderBookUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamOrderBookUpdatesClient) Recv() (*StreamOrderBookUpdate, error) {
+	m := new(StreamOrderBookUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	// OrderBookUpdates streams order book diffs and trades for the subscribed
+	// market outcomes, once per committed block in which they changed.
+	OrderBookUpdates(*StreamOrderBookRequest, Stream_OrderBookUpdatesServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) OrderBookUpdates(req *StreamOrderBookRequest, srv Stream_OrderBookUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method OrderBookUpdates not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_OrderBookUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrderBookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).OrderBookUpdates(m, &streamOrderBookUpdatesServer{stream})
+}
+
+type Stream_OrderBookUpdatesServer interface {
+	Send(*StreamOrderBookUpdate) error
+	grpc.ServerStream
+}
+
+type streamOrderBookUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamOrderBookUpdatesServer) Send(m *StreamOrderBookUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Stream_serviceDesc = _Stream_serviceDesc
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "speculod.prediction.v1.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "OrderBookUpdates",
+			Handler:       _Stream_OrderBookUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "speculod/prediction/v1/stream.proto",
+}
+
+func (m *StreamSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutcomeIndex != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.OutcomeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderBookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderBookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderBookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OutcomeIndex != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.OutcomeIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovStream(uint64(m.MarketId))
+	}
+	if m.OutcomeIndex != 0 {
+		n += 1 + sovStream(uint64(m.OutcomeIndex))
+	}
+	return n
+}
+
+func (m *StreamOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StreamOrderBookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovStream(uint64(m.MarketId))
+	}
+	if m.OutcomeIndex != 0 {
+		n += 1 + sovStream(uint64(m.OutcomeIndex))
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutcomeIndex", wireType)
+			}
+			m.OutcomeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutcomeIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamOrderBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamOrderBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, StreamSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamOrderBookUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamOrderBookUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamOrderBookUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutcomeIndex", wireType)
+			}
+			m.OutcomeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutcomeIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshot = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, OrderBookEntry{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, OrderBookEntry{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1078 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: speculod/prediction/v1/template.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketTemplate describes a market that is created again every few epochs of
+// an x/epochs identifier.
+type MarketTemplate struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// question may contain the {epoch} and {date} placeholders, replaced by the
+	// number of the epoch that ended and the UTC date of the block.
+	Question string   `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	Outcomes []string `protobuf:"bytes,4,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	GroupId  string   `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// duration is the number of seconds each market stays open.
+	Duration        int64  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	EpochIdentifier string `protobuf:"bytes,7,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// recurrence is the number of epochs between two markets.
+	Recurrence int64 `protobuf:"varint,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// bond is escrowed from the creator for every market and refunded once the
+	// market is resolved. The bond of the first market is escrowed when the
+	// template is created or resumed.
+	Bond *types.Coin `protobuf:"bytes,9,opt,name=bond,proto3" json:"bond,omitempty"`
+	// roll_bond moves the bond of the previous market to the new one while that
+	// market is unresolved instead of escrowing a new bond.
+	RollBond bool `protobuf:"varint,10,opt,name=roll_bond,json=rollBond,proto3" json:"roll_bond,omitempty"`
+	Active   bool `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
+	// last_market_id is the last market created from the template, valid when
+	// markets_created is not zero.
+	LastMarketId uint64 `protobuf:"varint,12,opt,name=last_market_id,json=lastMarketId,proto3" json:"last_market_id,omitempty"`
+	// last_epoch is the epoch number of the last recurrence, or of the creation
+	// or resumption of the template. The next market is due recurrence epochs
+	// later.
+	LastEpoch      int64  `protobuf:"varint,13,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+	MarketsCreated uint64 `protobuf:"varint,14,opt,name=markets_created,json=marketsCreated,proto3" json:"markets_created,omitempty"`
+	CreatedAt      int64  `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// idle_markets is the number of recurrences in a row whose market had no
+	// trades by the time the next one was due or could not be created.
+	IdleMarkets uint64 `protobuf:"varint,16,opt,name=idle_markets,json=idleMarkets,proto3" json:"idle_markets,omitempty"`
+	// bond_escrowed is set while the module holds the bond for the next market
+	// of the template. It is refunded when the template is paused.
+	BondEscrowed bool `protobuf:"varint,17,opt,name=bond_escrowed,json=bondEscrowed,proto3" json:"bond_escrowed,omitempty"`
+	// last_failed is set when the template could not create a market at its
+	// last recurrence.
+	LastFailed bool `protobuf:"varint,18,opt,name=last_failed,json=lastFailed,proto3" json:"last_failed,omitempty"`
+}
+
+func (m *MarketTemplate) Reset()         { *m = MarketTemplate{} }
+func (m *MarketTemplate) String() string { return proto.CompactTextString(m) }
+func (*MarketTemplate) ProtoMessage()    {}
+func (*MarketTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4c95e8dbf58f8ae, []int{0}
+}
+func (m *MarketTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketTemplate.Merge(m, src)
+}
+func (m *MarketTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketTemplate proto.InternalMessageInfo
+
+func (m *MarketTemplate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MarketTemplate) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MarketTemplate) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *MarketTemplate) GetOutcomes() []string {
+	if m != nil {
+		return m.Outcomes
+	}
+	return nil
+}
+
+func (m *MarketTemplate) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *MarketTemplate) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MarketTemplate) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *MarketTemplate) GetRecurrence() int64 {
+	if m != nil {
+		return m.Recurrence
+	}
+	return 0
+}
+
+func (m *MarketTemplate) GetBond() *types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+func (m *MarketTemplate) GetRollBond() bool {
+	if m != nil {
+		return m.RollBond
+	}
+	return false
+}
+
+func (m *MarketTemplate) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *MarketTemplate) GetLastMarketId() uint64 {
+	if m != nil {
+		return m.LastMarketId
+	}
+	return 0
+}
+
+func (m *MarketTemplate) GetLastEpoch() int64 {
+	if m != nil {
+		return m.LastEpoch
+	}
+	return 0
+}
+
+func (m *MarketTemplate) GetMarketsCreated() uint64 {
+	if m != nil {
+		return m.MarketsCreated
+	}
+	return 0
+}
+
+func (m *MarketTemplate) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *MarketTemplate) GetIdleMarkets() uint64 {
+	if m != nil {
+		return m.IdleMarkets
+	}
+	return 0
+}
+
+func (m *MarketTemplate) GetBondEscrowed() bool {
+	if m != nil {
+		return m.BondEscrowed
+	}
+	return false
+}
+
+func (m *MarketTemplate) GetLastFailed() bool {
+	if m != nil {
+		return m.LastFailed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*MarketTemplate)(nil), "speculod.prediction.v1.MarketTemplate")
+}
+
+func init() {
+	proto.RegisterFile("speculod/prediction/v1/template.proto", fileDescriptor_b4c95e8dbf58f8ae)
+}
+
+var fileDescriptor_b4c95e8dbf58f8ae = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x24, 0xa4, 0xc9, 0x24, 0x4d, 0xca, 0x1e, 0xaa, 0x6d, 0x2b, 0x8c, 0xf9, 0x27,
+	0xc2, 0x01, 0x5b, 0x01, 0xf1, 0x00, 0xb4, 0x2a, 0x52, 0x0e, 0x5c, 0x2c, 0x4e, 0x5c, 0x2c, 0x67,
+	0x77, 0x0a, 0x2b, 0x1c, 0xaf, 0xd9, 0x5d, 0x07, 0x78, 0x0b, 0x1e, 0x8b, 0x13, 0xea, 0x91, 0x23,
+	0x4a, 0x5e, 0x04, 0xed, 0xd8, 0x09, 0x3d, 0xce, 0x6f, 0xbe, 0xf9, 0x76, 0xe6, 0xd3, 0xc2, 0x33,
+	0x5b, 0xa1, 0xa8, 0x0b, 0x2d, 0x93, 0xca, 0xa0, 0x54, 0xc2, 0x29, 0x5d, 0x26, 0x9b, 0x45, 0xe2,
+	0x70, 0x5d, 0x15, 0xb9, 0xc3, 0xb8, 0x32, 0xda, 0x69, 0x76, 0xba, 0x97, 0xc5, 0xff, 0x65, 0xf1,
+	0x66, 0x71, 0x1e, 0x0a, 0x6d, 0xd7, 0xda, 0x26, 0xab, 0xdc, 0x62, 0xb2, 0x59, 0xac, 0xd0, 0xe5,
+	0x8b, 0x44, 0x68, 0x55, 0x36, 0x73, 0x8f, 0x7f, 0xf7, 0x61, 0xfa, 0x3e, 0x37, 0x5f, 0xd0, 0x7d,
+	0x68, 0x0d, 0xd9, 0x14, 0xba, 0x4a, 0xf2, 0x20, 0x0a, 0xe6, 0xfd, 0xb4, 0xab, 0x24, 0xe3, 0x70,
+	0x24, 0x0c, 0xe6, 0x4e, 0x1b, 0xde, 0x8d, 0x82, 0xf9, 0x28, 0xdd, 0x97, 0xec, 0x1c, 0x86, 0x5f,
+	0x6b, 0xb4, 0xfe, 0x2d, 0xde, 0xa3, 0xd6, 0xa1, 0xf6, 0x3d, 0x5d, 0x3b, 0xa1, 0xd7, 0x68, 0x79,
+	0x3f, 0xea, 0xf9, 0xde, 0xbe, 0x66, 0x67, 0x30, 0xfc, 0x64, 0x74, 0x5d, 0x65, 0x4a, 0xf2, 0x7b,
+	0x8d, 0x25, 0xd5, 0x4b, 0xe9, 0xc7, 0x64, 0x6d, 0x72, 0xb2, 0x1c, 0x44, 0xc1, 0xbc, 0x97, 0x1e,
+	0x6a, 0xf6, 0x02, 0x4e, 0xb0, 0xd2, 0xe2, 0x73, 0xa6, 0x24, 0x96, 0x4e, 0xdd, 0x28, 0x34, 0xfc,
+	0x88, 0xc6, 0x67, 0xc4, 0x97, 0x07, 0xcc, 0x42, 0x00, 0x83, 0xa2, 0x36, 0x06, 0x4b, 0x81, 0x7c,
+	0x48, 0x46, 0x77, 0x08, 0x7b, 0x09, 0xfd, 0x95, 0x2e, 0x25, 0x1f, 0x45, 0xc1, 0x7c, 0xfc, 0xea,
+	0x2c, 0x6e, 0x52, 0x8a, 0x7d, 0x4a, 0x71, 0x9b, 0x52, 0x7c, 0xa5, 0x55, 0x99, 0x92, 0x8c, 0x5d,
+	0xc0, 0xc8, 0xe8, 0xa2, 0xc8, 0x68, 0x06, 0xa2, 0x60, 0x3e, 0x4c, 0x87, 0x1e, 0x5c, 0xfa, 0xe6,
+	0x29, 0x0c, 0x72, 0xe1, 0xd4, 0x06, 0xf9, 0x98, 0x3a, 0x6d, 0xc5, 0x9e, 0xc2, 0xb4, 0xc8, 0xad,
+	0xcb, 0xd6, 0x14, 0xaf, 0xbf, 0x75, 0x42, 0x99, 0x4e, 0x3c, 0x6d, 0x32, 0x5f, 0x4a, 0xf6, 0x00,
+	0x80, 0x54, 0x74, 0x01, 0x3f, 0xa6, 0x4d, 0x47, 0x9e, 0x5c, 0x7b, 0xc0, 0x9e, 0xc3, 0xac, 0x99,
+	0xb7, 0x19, 0xa5, 0x8e, 0x92, 0x4f, 0xc9, 0x65, 0xda, 0xe2, 0xab, 0x86, 0x7a, 0x9f, 0x56, 0x90,
+	0xe5, 0x8e, 0xcf, 0x1a, 0x9f, 0x96, 0xbc, 0x75, 0xec, 0x11, 0x4c, 0x94, 0x2c, 0xb0, 0x5d, 0xc6,
+	0xf2, 0x13, 0x32, 0x19, 0x7b, 0xd6, 0xac, 0x62, 0xd9, 0x13, 0x38, 0xf6, 0xf7, 0x65, 0x68, 0x85,
+	0xd1, 0xdf, 0x50, 0xf2, 0xfb, 0x74, 0xce, 0xc4, 0xc3, 0xeb, 0x96, 0xb1, 0x87, 0x30, 0xa6, 0x75,
+	0x6f, 0x72, 0x55, 0xa0, 0xe4, 0x8c, 0x24, 0x74, 0xc1, 0x3b, 0x22, 0x97, 0x6f, 0x7e, 0x6d, 0xc3,
+	0xe0, 0x76, 0x1b, 0x06, 0x7f, 0xb7, 0x61, 0xf0, 0x73, 0x17, 0x76, 0x6e, 0x77, 0x61, 0xe7, 0xcf,
+	0x2e, 0xec, 0x7c, 0xbc, 0x38, 0xfc, 0xe4, 0xef, 0x77, 0xff, 0xb2, 0xfb, 0x51, 0xa1, 0x5d, 0x0d,
+	0xe8, 0x3b, 0xbe, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x20, 0xd4, 0x1f, 0x49, 0xef, 0x02, 0x00,
+	0x00,
+}
+
+func (m *MarketTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastFailed {
+		i--
+		if m.LastFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.BondEscrowed {
+		i--
+		if m.BondEscrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.IdleMarkets != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.IdleMarkets))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MarketsCreated != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.MarketsCreated))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LastEpoch != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.LastEpoch))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.LastMarketId != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.LastMarketId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RollBond {
+		i--
+		if m.RollBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Bond != nil {
+		{
+			size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTemplate(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Recurrence != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.Recurrence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTemplate(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintTemplate(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Outcomes) > 0 {
+		for iNdEx := len(m.Outcomes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Outcomes[iNdEx])
+			copy(dAtA[i:], m.Outcomes[iNdEx])
+			i = encodeVarintTemplate(dAtA, i, uint64(len(m.Outcomes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Question) > 0 {
+		i -= len(m.Question)
+		copy(dAtA[i:], m.Question)
+		i = encodeVarintTemplate(dAtA, i, uint64(len(m.Question)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTemplate(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTemplate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTemplate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTemplate(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTemplate(uint64(l))
+	}
+	l = len(m.Question)
+	if l > 0 {
+		n += 1 + l + sovTemplate(uint64(l))
+	}
+	if len(m.Outcomes) > 0 {
+		for _, s := range m.Outcomes {
+			l = len(s)
+			n += 1 + l + sovTemplate(uint64(l))
+		}
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovTemplate(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTemplate(uint64(m.Duration))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTemplate(uint64(l))
+	}
+	if m.Recurrence != 0 {
+		n += 1 + sovTemplate(uint64(m.Recurrence))
+	}
+	if m.Bond != nil {
+		l = m.Bond.Size()
+		n += 1 + l + sovTemplate(uint64(l))
+	}
+	if m.RollBond {
+		n += 2
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.LastMarketId != 0 {
+		n += 1 + sovTemplate(uint64(m.LastMarketId))
+	}
+	if m.LastEpoch != 0 {
+		n += 1 + sovTemplate(uint64(m.LastEpoch))
+	}
+	if m.MarketsCreated != 0 {
+		n += 1 + sovTemplate(uint64(m.MarketsCreated))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTemplate(uint64(m.CreatedAt))
+	}
+	if m.IdleMarkets != 0 {
+		n += 2 + sovTemplate(uint64(m.IdleMarkets))
+	}
+	if m.BondEscrowed {
+		n += 3
+	}
+	if m.LastFailed {
+		n += 3
+	}
+	return n
+}
+
+func sovTemplate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTemplate(x uint64) (n int) {
+	return sovTemplate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Question", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Question = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcomes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outcomes = append(m.Outcomes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			m.Recurrence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recurrence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bond == nil {
+				m.Bond = &types.Coin{}
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RollBond = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMarketId", wireType)
+			}
+			m.LastMarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+			}
+			m.LastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketsCreated", wireType)
+			}
+			m.MarketsCreated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketsCreated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleMarkets", wireType)
+			}
+			m.IdleMarkets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleMarkets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondEscrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BondEscrowed = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LastFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTemplate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTemplate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTemplate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTemplate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTemplate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTemplate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTemplate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTemplate = fmt.Errorf("proto: unexpected end of group")
+)
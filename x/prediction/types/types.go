@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -13,11 +14,31 @@ var (
 	ErrInvalidRequest = errorsmod.Register(ModuleName, 1, "invalid request")
 )
 
+// Market statuses
+const (
+	MarketStatusOpen     = "open"
+	MarketStatusClosed   = "closed"
+	MarketStatusResolved = "resolved"
+)
+
 // PositionKey builds a unique key for a user position in a market
 func PositionKey(marketID uint64, user string) []byte {
 	return append([]byte("position/"), address.MustLengthPrefix([]byte(fmt.Sprintf("%d/%s", marketID, user)))...)
 }
 
+// ParsePrice parses the price of an order: a decimal strictly between 0 and 1,
+// the share of the payout a winning share is traded at.
+func ParsePrice(price string) (math.LegacyDec, error) {
+	dec, err := math.LegacyNewDecFromStr(price)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(ErrInvalidPrice, "price %q is not a decimal", price)
+	}
+	if !dec.IsPositive() || dec.GTE(math.LegacyOneDec()) {
+		return math.LegacyDec{}, errorsmod.Wrapf(ErrInvalidPrice, "price %s is not between 0 and 1", dec)
+	}
+	return dec, nil
+}
+
 // OutcomeResult stores the final resolved outcome of a prediction market
 type OutcomeResult struct {
 	MarketId     uint64 `json:"market_id" yaml:"market_id"`
@@ -37,3 +58,11 @@ func ValidateOutcome(outcomes []string, vote string) error {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// Nothing needed for now
 }
+
+// Opposite returns the side that trades against s
+func (s OrderSide) Opposite() OrderSide {
+	if s == ORDER_SIDE_BUY {
+		return ORDER_SIDE_SELL
+	}
+	return ORDER_SIDE_BUY
+}
@@ -3,12 +3,23 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"speculod/x/reputation/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, score := range genState.ReputationScores {
+		if err := k.ReputationScores.Set(ctx, scoreKey(score.Address, score.GroupId), score); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -21,5 +32,12 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	if err := k.ReputationScores.Walk(ctx, nil, func(_ collections.Pair[string, string], score types.ReputationScore) (bool, error) {
+		genesis.ReputationScores = append(genesis.ReputationScores, score)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -5,12 +5,17 @@ import (
 
 	"speculod/x/reputation/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		ReputationScores: []types.ReputationScore{
+			{Address: sdk.AccAddress([]byte("alice_______________")).String(), GroupId: "weather", Score: "10"},
+			{Address: sdk.AccAddress([]byte("bob_________________")).String(), GroupId: "sports", Score: "4"},
+		},
 	}
 
 	f := initFixture(t)
@@ -21,4 +26,9 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.ReputationScores, got.ReputationScores)
+
+	score, found := f.keeper.GetReputationScore(sdk.UnwrapSDKContext(f.ctx), genesisState.ReputationScores[0].Address, "weather")
+	require.True(t, found)
+	require.Equal(t, "10", score)
 }
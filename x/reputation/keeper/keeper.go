@@ -21,11 +21,13 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
+	predictionKeeper types.PredictionKeeper
+
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 
-	// Reputation score storage
-	ReputationScores collections.Map[string, types.ReputationScore]
+	// Reputation score storage, keyed by address and group
+	ReputationScores collections.Map[collections.Pair[string, string], types.ReputationScore]
 }
 
 func NewKeeper(
@@ -33,7 +35,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	predictionKeeper types.PredictionKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -47,8 +49,11 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		ReputationScores: collections.NewMap(sb, collections.NewPrefix("reputation_scores"), "reputation_scores", collections.StringKey, codec.CollValue[types.ReputationScore](cdc)),
+		predictionKeeper: predictionKeeper,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ReputationScores: collections.NewMap(sb, types.ReputationScoresKey, "reputation_scores",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.ReputationScore](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -65,10 +70,14 @@ func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
+// scoreKey builds the storage key of an address's score in a group
+func scoreKey(address string, groupId string) collections.Pair[string, string] {
+	return collections.Join(address, groupId)
+}
+
 // GetReputationScore retrieves a reputation score for a user in a specific group
 func (k Keeper) GetReputationScore(ctx sdk.Context, address string, groupId string) (string, bool) {
-	key := fmt.Sprintf("%s:%s", address, groupId)
-	score, err := k.ReputationScores.Get(ctx, key)
+	score, err := k.ReputationScores.Get(ctx, scoreKey(address, groupId))
 	if err != nil {
 		return "0", false
 	}
@@ -77,13 +86,12 @@ func (k Keeper) GetReputationScore(ctx sdk.Context, address string, groupId stri
 
 // SetReputationScore stores a reputation score for a user in a specific group
 func (k Keeper) SetReputationScore(ctx sdk.Context, address string, groupId string, score string) error {
-	key := fmt.Sprintf("%s:%s", address, groupId)
 	reputationScore := types.ReputationScore{
 		Address: address,
 		Score:   score,
 		GroupId: groupId,
 	}
-	return k.ReputationScores.Set(ctx, key, reputationScore)
+	return k.ReputationScores.Set(ctx, scoreKey(address, groupId), reputationScore)
 }
 
 // AdjustReputationScore adjusts a user's reputation score in a specific group
@@ -105,5 +113,15 @@ func (k Keeper) AdjustReputationScore(ctx sdk.Context, address string, groupId s
 	}
 
 	newScoreStr := strconv.FormatInt(newScore, 10)
-	return k.SetReputationScore(ctx, address, groupId, newScoreStr)
+	if err := k.SetReputationScore(ctx, address, groupId, newScoreStr); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventReputationAdjusted{
+		Address:       address,
+		GroupId:       groupId,
+		Adjustment:    adjustment,
+		PreviousScore: strconv.FormatInt(currentScore, 10),
+		NewScore:      newScoreStr,
+	})
 }
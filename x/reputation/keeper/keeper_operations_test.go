@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"speculod/x/reputation/types"
 )
 
 func TestKeeperOperations(t *testing.T) {
@@ -59,6 +62,26 @@ func TestKeeperOperations(t *testing.T) {
 		require.Equal(t, "0", score)
 	})
 
+	t.Run("adjustment event", func(t *testing.T) {
+		sdkCtx := ctx.(sdk.Context).WithEventManager(sdk.NewEventManager())
+		userAddr := "cosmos1event0000000000000000000000000000000000000"
+
+		require.NoError(t, f.keeper.AdjustReputationScore(sdkCtx, userAddr, "test-group", 4))
+		require.NoError(t, f.keeper.AdjustReputationScore(sdkCtx, userAddr, "test-group", -6))
+
+		events := sdkCtx.EventManager().Events()
+		require.Len(t, events, 2)
+		msg, err := sdk.ParseTypedEvent(abci.Event(events[1]))
+		require.NoError(t, err)
+		require.Equal(t, &types.EventReputationAdjusted{
+			Address:       userAddr,
+			GroupId:       "test-group",
+			Adjustment:    -6,
+			PreviousScore: "4",
+			NewScore:      "0",
+		}, msg)
+	})
+
 	t.Run("authority operations", func(t *testing.T) {
 		authority := f.keeper.GetAuthority()
 		require.NotNil(t, authority)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"cosmossdk.io/core/address"
@@ -12,9 +13,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"go.uber.org/mock/gomock"
 
 	"speculod/x/reputation/keeper"
 	module "speculod/x/reputation/module"
+	reputationtestutil "speculod/x/reputation/testutil"
 	"speculod/x/reputation/types"
 )
 
@@ -36,11 +39,17 @@ func initFixture(t *testing.T) *fixture {
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 
+	// Every group but unknown-group is registered in x/prediction
+	predictionKeeper := reputationtestutil.NewMockPredictionKeeper(gomock.NewController(t))
+	predictionKeeper.EXPECT().HasMarketGroup(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, groupId string) bool { return groupId != "unknown-group" }).AnyTimes()
+
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		predictionKeeper,
 	)
 
 	// Initialize params
@@ -96,6 +105,19 @@ func TestMsgAdjustScore(t *testing.T) {
 		}
 	})
 
+	t.Run("adjust score in an unregistered group", func(t *testing.T) {
+		msg := &types.MsgAdjustScore{
+			Address:    userAddr,
+			GroupId:    "unknown-group",
+			Adjustment: 3,
+			Authority:  correctAuthorityStr,
+		}
+		_, err := msgServer.AdjustScore(ctx, msg)
+		if !errors.Is(err, types.ErrGroupNotFound) {
+			t.Fatalf("expected group not found error, got %v", err)
+		}
+	})
+
 	t.Run("negative adjustment does not go below zero", func(t *testing.T) {
 		msg := &types.MsgAdjustScore{
 			Address:    userAddr,
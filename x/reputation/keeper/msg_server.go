@@ -32,6 +32,11 @@ func (s msgServer) AdjustScore(goCtx context.Context, msg *types.MsgAdjustScore)
 		return nil, errors.Wrap(types.ErrInvalidSigner, "unauthorized: authority does not match")
 	}
 
+	// Scores outside any group are global, grouped scores need a registered group
+	if msg.GroupId != "" && !s.predictionKeeper.HasMarketGroup(ctx, msg.GroupId) {
+		return nil, errors.Wrapf(types.ErrGroupNotFound, "group %s", msg.GroupId)
+	}
+
 	err = s.AdjustReputationScore(ctx, msg.Address, msg.GroupId, msg.Adjustment)
 	if err != nil {
 		// TODO: Add more granular error handling for logic errors
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/x/reputation/types"
+)
+
+func (q queryServer) Score(ctx context.Context, req *types.QueryScoreRequest) (*types.QueryScoreResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	score, err := q.k.ReputationScores.Get(ctx, scoreKey(req.Address, req.GroupId))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no score for %s in group %q", req.Address, req.GroupId)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryScoreResponse{Score: score}, nil
+}
+
+func (q queryServer) Scores(ctx context.Context, req *types.QueryScoresRequest) (*types.QueryScoresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.Address != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.Address))
+	}
+	scores, pageRes, err := query.CollectionPaginate(ctx, q.k.ReputationScores, req.Pagination,
+		func(_ collections.Pair[string, string], s types.ReputationScore) (types.ReputationScore, error) {
+			return s, nil
+		},
+		opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryScoresResponse{Scores: scores, Pagination: pageRes}, nil
+}
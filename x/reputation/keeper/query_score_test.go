@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"speculod/x/reputation/keeper"
+	"speculod/x/reputation/types"
+)
+
+func TestScoreQueries(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	require.NoError(t, f.keeper.SetReputationScore(ctx, "alice", "", "12"))
+	require.NoError(t, f.keeper.SetReputationScore(ctx, "alice", "weather", "7"))
+	require.NoError(t, f.keeper.SetReputationScore(ctx, "bob", "", "3"))
+
+	res, err := qs.Score(ctx, &types.QueryScoreRequest{Address: "alice", GroupId: "weather"})
+	require.NoError(t, err)
+	require.Equal(t, types.ReputationScore{Address: "alice", Score: "7", GroupId: "weather"}, res.Score)
+
+	_, err = qs.Score(ctx, &types.QueryScoreRequest{Address: "bob", GroupId: "weather"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.Score(ctx, &types.QueryScoreRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	all, err := qs.Scores(ctx, &types.QueryScoresRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Scores, 3)
+
+	alice, err := qs.Scores(ctx, &types.QueryScoresRequest{Address: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, []types.ReputationScore{
+		{Address: "alice", Score: "12"},
+		{Address: "alice", Score: "7", GroupId: "weather"},
+	}, alice.Scores)
+
+	page, err := qs.Scores(ctx, &types.QueryScoresRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, page.Scores, 2)
+	require.Equal(t, uint64(3), page.Pagination.Total)
+}
@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"speculod/x/reputation/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. Scores were
+// stored under "address:groupId" string keys; v2 stores them under
+// (address, groupId) pairs so the scores of an address can be range scanned.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	legacy := collections.NewMap(sb, types.ReputationScoresKey, "reputation_scores", collections.StringKey, codec.CollValue[types.ReputationScore](cdc))
+	scores := collections.NewMap(sb, types.ReputationScoresKey, "reputation_scores",
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.ReputationScore](cdc))
+
+	// Both maps share a prefix, so collect the legacy entries before rewriting
+	kvs, err := legacy.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	entries, err := kvs.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range entries {
+		if err := legacy.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+		if err := scores.Set(ctx, collections.Join(kv.Value.Address, kv.Value.GroupId), kv.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
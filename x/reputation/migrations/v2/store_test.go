@@ -0,0 +1,51 @@
+package v2_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/reputation/keeper"
+	v2 "speculod/x/reputation/migrations/v2"
+	module "speculod/x/reputation/module"
+	"speculod/x/reputation/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	// v1 stored scores under "address:groupId" string keys
+	scores := []types.ReputationScore{
+		{Address: "alice", GroupId: "weather", Score: "12"},
+		{Address: "alice", GroupId: "sports", Score: "3"},
+		{Address: "bob", GroupId: "weather", Score: "0"},
+	}
+	store := ctx.KVStore(storeKey)
+	legacyKey := func(score types.ReputationScore) []byte {
+		return append(append([]byte{}, types.ReputationScoresKey...), score.Address+":"+score.GroupId...)
+	}
+	for _, score := range scores {
+		store.Set(legacyKey(score), cdc.MustMarshal(&score))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	k := keeper.NewKeeper(storeService, cdc, addressCodec, authtypes.NewModuleAddress(types.GovModuleName), nil)
+	for _, score := range scores {
+		got, found := k.GetReputationScore(ctx, score.Address, score.GroupId)
+		require.True(t, found)
+		require.Equal(t, score.Score, got)
+		require.False(t, store.Has(legacyKey(score)))
+	}
+}
@@ -17,6 +17,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "Score",
+					Use:            "score [address]",
+					Short:          "Show the reputation score of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"group_id": {Usage: "group of the score, empty for the global score"},
+					},
+				},
+				{
+					RpcMethod:      "Scores",
+					Use:            "scores [address]",
+					Short:          "List reputation scores, optionally of a single address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address", Optional: true}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +43,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "AdjustScore",
+					Skip:      true, // skipped because authority gated
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -33,7 +33,8 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
+	AuthKeeper       types.AuthKeeper
+	PredictionKeeper types.PredictionKeeper
 	// BankKeeper types.BankKeeper // Temporarily commented out
 }
 
@@ -55,8 +56,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.PredictionKeeper,
 	)
-	m := NewAppModule(in.Cdc, k, in.AuthKeeper, nil) // Temporarily pass nil for BankKeeper
+	m := NewAppModule(in.Cdc, k, in.AuthKeeper, nil, in.PredictionKeeper) // Temporarily pass nil for BankKeeper
 
 	return ModuleOutputs{ReputationKeeper: k, Module: m}
 }
@@ -1,12 +1,22 @@
 package reputation
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	predictiontypes "speculod/x/prediction/types"
+	reputationsimulation "speculod/x/reputation/simulation"
 	"speculod/x/reputation/types"
 )
 
+const (
+	opWeightMsgAdjustScore          = "op_weight_msg_adjust_score"
+	defaultWeightMsgAdjustScore int = 50
+)
+
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	accs := make([]string, len(simState.Accounts))
@@ -16,13 +26,41 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	reputationGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
 	}
+
+	// Scores are kept in the groups registered in the prediction genesis,
+	// which is generated first
+	var groupIds []string
+	if raw, ok := simState.GenState[predictiontypes.ModuleName]; ok {
+		var predictionGenesis predictiontypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(raw, &predictionGenesis)
+		for _, g := range predictionGenesis.Groups {
+			groupIds = append(groupIds, g.Id)
+		}
+	}
+
+	// About half of the accounts start with a score in one group
+	for _, acc := range accs {
+		if simState.Rand.Intn(2) == 0 {
+			continue
+		}
+		reputationGenesis.ReputationScores = append(reputationGenesis.ReputationScores, types.ReputationScore{
+			Address: acc,
+			GroupId: reputationsimulation.RandomGroupId(simState.Rand, groupIds),
+			Score:   strconv.Itoa(simState.Rand.Intn(100)),
+		})
+	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&reputationGenesis)
 }
 
-// RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+// RegisterStoreDecoder registers a decoder that pretty-prints the values of
+// the module's collections by key prefix.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
+// Scores are only adjusted by the module authority, so they are simulated
+// through governance proposals instead, see ProposalMsgs.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 	return operations
@@ -30,5 +68,11 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgAdjustScore,
+			defaultWeightMsgAdjustScore,
+			reputationsimulation.SimulateMsgAdjustScore(am.keeper, am.predictionKeeper),
+		),
+	}
 }
@@ -0,0 +1,56 @@
+package reputation_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/reputation/keeper"
+	reputation "speculod/x/reputation/module"
+	"speculod/x/reputation/types"
+)
+
+func TestStoreDecoder(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(reputation.AppModule{}).Codec
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storetypes.NewKVStoreKey(types.StoreKey)),
+		cdc,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil,
+	)
+	sdr := make(simtypes.StoreDecoderRegistry)
+	reputation.NewAppModule(cdc, k, nil, nil, nil).RegisterStoreDecoder(sdr)
+	dec := sdr[types.StoreKey]
+
+	scoreA := types.ReputationScore{Address: "alice", GroupId: "weather", Score: "12"}
+	scoreB := types.ReputationScore{Address: "alice", GroupId: "weather", Score: "13"}
+	scoreKey, err := collections.EncodeKeyWithPrefix(k.ReputationScores.GetPrefix(), k.ReputationScores.KeyCodec(), collections.Join("alice", "weather"))
+	require.NoError(t, err)
+	params := types.DefaultParams()
+
+	tests := []struct {
+		name        string
+		kvA, kvB    kv.Pair
+		expectedLog string
+	}{
+		{"score", kv.Pair{Key: scoreKey, Value: cdc.MustMarshal(&scoreA)}, kv.Pair{Key: scoreKey, Value: cdc.MustMarshal(&scoreB)}, scoreA.String() + "\n" + scoreB.String()},
+		{"params", kv.Pair{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)}, kv.Pair{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)}, params.String() + "\n" + params.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLog, dec(tt.kvA, tt.kvB))
+		})
+	}
+
+	require.Panics(t, func() { dec(kv.Pair{Key: []byte("unknown")}, kv.Pair{Key: []byte("unknown")}) })
+}
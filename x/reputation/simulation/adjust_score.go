@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"speculod/x/reputation/keeper"
+	"speculod/x/reputation/types"
+)
+
+// RandomGroupId returns either the empty group of ungrouped markets or one of
+// the registered market groups
+func RandomGroupId(r *rand.Rand, groupIds []string) string {
+	n := r.Intn(len(groupIds) + 1)
+	if n == 0 {
+		return ""
+	}
+	return groupIds[n-1]
+}
+
+// SimulateMsgAdjustScore returns a MsgAdjustScore signed by the module
+// authority, to be submitted through a governance proposal.
+func SimulateMsgAdjustScore(k keeper.Keeper, pk types.PredictionKeeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+		var groupIds []string
+		for _, g := range pk.GetAllMarketGroups(ctx) {
+			groupIds = append(groupIds, g.Id)
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		return &types.MsgAdjustScore{
+			Address:    simAccount.Address.String(),
+			GroupId:    RandomGroupId(r, groupIds),
+			Adjustment: int64(simtypes.RandIntBetween(r, -10, 11)),
+			Authority:  sdk.AccAddress(k.GetAuthority()).String(),
+		}
+	}
+}
@@ -0,0 +1,210 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: x/reputation/types/expected_keepers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=x/reputation/types/expected_keepers.go -package testutil -destination x/reputation/testutil/expected_keepers_mocks.go
+//
+
+// Package testutil is a generated GoMock package.
+package testutil
+
+import (
+	context "context"
+	reflect "reflect"
+	types0 "speculod/x/prediction/types"
+
+	address "cosmossdk.io/core/address"
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthKeeper is a mock of AuthKeeper interface.
+type MockAuthKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthKeeperMockRecorder is the mock recorder for MockAuthKeeper.
+type MockAuthKeeperMockRecorder struct {
+	mock *MockAuthKeeper
+}
+
+// NewMockAuthKeeper creates a new mock instance.
+func NewMockAuthKeeper(ctrl *gomock.Controller) *MockAuthKeeper {
+	mock := &MockAuthKeeper{ctrl: ctrl}
+	mock.recorder = &MockAuthKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthKeeper) EXPECT() *MockAuthKeeperMockRecorder {
+	return m.recorder
+}
+
+// AddressCodec mocks base method.
+func (m *MockAuthKeeper) AddressCodec() address.Codec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressCodec")
+	ret0, _ := ret[0].(address.Codec)
+	return ret0
+}
+
+// AddressCodec indicates an expected call of AddressCodec.
+func (mr *MockAuthKeeperMockRecorder) AddressCodec() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressCodec", reflect.TypeOf((*MockAuthKeeper)(nil).AddressCodec))
+}
+
+// GetAccount mocks base method.
+func (m *MockAuthKeeper) GetAccount(arg0 context.Context, arg1 types.AccAddress) types.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(types.AccountI)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockAuthKeeperMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAuthKeeper)(nil).GetAccount), arg0, arg1)
+}
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// SpendableCoins mocks base method.
+func (m *MockBankKeeper) SpendableCoins(arg0 context.Context, arg1 types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoins", arg0, arg1)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// SpendableCoins indicates an expected call of SpendableCoins.
+func (mr *MockBankKeeperMockRecorder) SpendableCoins(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), arg0, arg1)
+}
+
+// MockPredictionKeeper is a mock of PredictionKeeper interface.
+type MockPredictionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockPredictionKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockPredictionKeeperMockRecorder is the mock recorder for MockPredictionKeeper.
+type MockPredictionKeeperMockRecorder struct {
+	mock *MockPredictionKeeper
+}
+
+// NewMockPredictionKeeper creates a new mock instance.
+func NewMockPredictionKeeper(ctrl *gomock.Controller) *MockPredictionKeeper {
+	mock := &MockPredictionKeeper{ctrl: ctrl}
+	mock.recorder = &MockPredictionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPredictionKeeper) EXPECT() *MockPredictionKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetAllMarketGroups mocks base method.
+func (m *MockPredictionKeeper) GetAllMarketGroups(ctx context.Context) []types0.MarketGroup {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMarketGroups", ctx)
+	ret0, _ := ret[0].([]types0.MarketGroup)
+	return ret0
+}
+
+// GetAllMarketGroups indicates an expected call of GetAllMarketGroups.
+func (mr *MockPredictionKeeperMockRecorder) GetAllMarketGroups(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMarketGroups", reflect.TypeOf((*MockPredictionKeeper)(nil).GetAllMarketGroups), ctx)
+}
+
+// HasMarketGroup mocks base method.
+func (m *MockPredictionKeeper) HasMarketGroup(ctx context.Context, groupId string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasMarketGroup", ctx, groupId)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasMarketGroup indicates an expected call of HasMarketGroup.
+func (mr *MockPredictionKeeperMockRecorder) HasMarketGroup(ctx, groupId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMarketGroup", reflect.TypeOf((*MockPredictionKeeper)(nil).HasMarketGroup), ctx, groupId)
+}
+
+// MockParamSubspace is a mock of ParamSubspace interface.
+type MockParamSubspace struct {
+	ctrl     *gomock.Controller
+	recorder *MockParamSubspaceMockRecorder
+	isgomock struct{}
+}
+
+// MockParamSubspaceMockRecorder is the mock recorder for MockParamSubspace.
+type MockParamSubspaceMockRecorder struct {
+	mock *MockParamSubspace
+}
+
+// NewMockParamSubspace creates a new mock instance.
+func NewMockParamSubspace(ctrl *gomock.Controller) *MockParamSubspace {
+	mock := &MockParamSubspace{ctrl: ctrl}
+	mock.recorder = &MockParamSubspaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParamSubspace) EXPECT() *MockParamSubspaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockParamSubspace) Get(arg0 context.Context, arg1 []byte, arg2 any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockParamSubspaceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockParamSubspace)(nil).Get), arg0, arg1, arg2)
+}
+
+// Set mocks base method.
+func (m *MockParamSubspace) Set(arg0 context.Context, arg1 []byte, arg2 any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", arg0, arg1, arg2)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockParamSubspaceMockRecorder) Set(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockParamSubspace)(nil).Set), arg0, arg1, arg2)
+}
@@ -9,4 +9,5 @@ import (
 // x/reputation module sentinel errors
 var (
 	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrGroupNotFound = errors.Register(ModuleName, 1101, "group not found")
 )
@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	predictiontypes "speculod/x/prediction/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -20,6 +22,12 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// PredictionKeeper defines the expected interface for the Prediction module.
+type PredictionKeeper interface {
+	HasMarketGroup(ctx context.Context, groupId string) bool
+	GetAllMarketGroups(ctx context.Context) []predictiontypes.MarketGroup // only used for simulation
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
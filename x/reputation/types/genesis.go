@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +17,28 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[[2]string]struct{}, len(gs.ReputationScores))
+	for _, score := range gs.ReputationScores {
+		if _, err := sdk.AccAddressFromBech32(score.Address); err != nil {
+			return fmt.Errorf("invalid reputation score address %s: %w", score.Address, err)
+		}
+		key := [2]string{score.Address, score.GroupId}
+		if _, dup := seen[key]; dup {
+			return fmt.Errorf("duplicate reputation score for %s in group %s", score.Address, score.GroupId)
+		}
+		seen[key] = struct{}{}
+
+		value, err := strconv.ParseInt(score.Score, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid reputation score %q for %s in group %s: %w", score.Score, score.Address, score.GroupId, err)
+		}
+		if value < 0 {
+			return fmt.Errorf("negative reputation score %d for %s in group %s", value, score.Address, score.GroupId)
+		}
+	}
+	return nil
 }
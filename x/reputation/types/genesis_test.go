@@ -5,10 +5,14 @@ import (
 
 	"speculod/x/reputation/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -24,6 +28,45 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "scores in several groups",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ReputationScores: []types.ReputationScore{
+					{Address: alice, GroupId: "weather", Score: "10"},
+					{Address: alice, GroupId: "sports", Score: "0"},
+					{Address: bob, GroupId: "weather", Score: "3"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate address and group",
+			genState: &types.GenesisState{
+				ReputationScores: []types.ReputationScore{
+					{Address: alice, GroupId: "weather", Score: "10"},
+					{Address: alice, GroupId: "weather", Score: "5"},
+				},
+			},
+		},
+		{
+			desc: "invalid address",
+			genState: &types.GenesisState{
+				ReputationScores: []types.ReputationScore{{Address: "alice", GroupId: "weather", Score: "10"}},
+			},
+		},
+		{
+			desc: "negative score",
+			genState: &types.GenesisState{
+				ReputationScores: []types.ReputationScore{{Address: alice, GroupId: "weather", Score: "-1"}},
+			},
+		},
+		{
+			desc: "non numeric score",
+			genState: &types.GenesisState{
+				ReputationScores: []types.ReputationScore{{Address: bob, GroupId: "weather", Score: "high"}},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -17,3 +17,6 @@ const (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_reputation")
+
+// ReputationScoresKey is the prefix of the reputation scores collection
+var ReputationScoresKey = collections.NewPrefix("reputation_scores")
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	predictiontypes "speculod/x/prediction/types"
+	"speculod/x/settlement/types"
+)
+
+// nonceSize is the number of random bytes of a generated vote nonce
+const nonceSize = 32
+
+// FlagOverwrite replaces a stored vote that was never committed on chain
+const FlagOverwrite = "overwrite"
+
+// GetTxCmd returns the custom transaction commands of the settlement module.
+// The other commands are generated by AutoCLI.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(CmdVote())
+	return cmd
+}
+
+// CmdVote commits a vote with a random nonce kept in the local vote store
+func CmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [market-id] [vote]",
+		Short: "Commit a vote and store its nonce for the reveal",
+		Long: `Commit a vote on a market with a random nonce. The vote and nonce are
+encrypted with a key derived from the --from key and stored under
+<home>/votes/<chain-id>/<address>, so "vote reveal" can reveal them later.
+The key must be a local key of the keyring: Ledger, offline and multisig
+keys cannot derive the encryption key and are rejected.
+
+If a vote is already stored but its commit never made it on chain, running
+the command again with the same vote broadcasts the stored commitment again.
+--overwrite replaces the stored vote with a new one instead.`,
+		Example: "vote 4 Yes --from alice\nvote reveal 4 --from alice\nvote 4 No --from alice --overwrite",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id %s: %w", args[0], err)
+			}
+			vote := args[1]
+
+			if !clientCtx.Offline {
+				res, err := predictiontypes.NewQueryClient(clientCtx).Market(cmd.Context(), &predictiontypes.QueryMarketRequest{MarketId: marketId})
+				if err != nil {
+					return err
+				}
+				if !slices.Contains(res.Market.Outcomes, vote) {
+					return fmt.Errorf("%s is not an outcome of market %d, expected one of %v", vote, marketId, res.Market.Outcomes)
+				}
+			}
+
+			store, err := newVoteStore(clientCtx)
+			if err != nil {
+				return err
+			}
+			voter := clientCtx.GetFromAddress().String()
+			if store.has(marketId) {
+				// A stored vote whose commit is on chain is the only copy of
+				// the nonce needed to reveal it
+				if !clientCtx.Offline {
+					committed, err := hasCommit(cmd, clientCtx, marketId, voter)
+					if err != nil {
+						return err
+					}
+					if committed {
+						return fmt.Errorf("a vote is already committed for market %d, reveal it with \"vote reveal %d\"", marketId, marketId)
+					}
+				}
+				overwrite, err := cmd.Flags().GetBool(FlagOverwrite)
+				if err != nil {
+					return err
+				}
+				if !overwrite {
+					entry, err := store.load(marketId)
+					if err != nil {
+						return err
+					}
+					if entry.Vote != vote {
+						return fmt.Errorf("a vote for %s is already stored for market %d, vote %s again to broadcast its commitment or replace it with --%s", entry.Vote, marketId, entry.Vote, FlagOverwrite)
+					}
+					return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &types.MsgCommitVote{
+						Creator:    entry.Voter,
+						MarketId:   marketId,
+						Commitment: entry.Commitment,
+					})
+				}
+			}
+
+			nonce := make([]byte, nonceSize)
+			if _, err := rand.Read(nonce); err != nil {
+				return err
+			}
+			entry := voteEntry{
+				MarketId: marketId,
+				Voter:    voter,
+				Vote:     vote,
+				Nonce:    hex.EncodeToString(nonce),
+			}
+			entry.Commitment = types.VoteCommitment(entry.Vote, entry.Nonce)
+
+			// The nonce is stored before broadcasting, so it cannot be lost
+			// once the commit is on chain
+			if err := store.save(entry); err != nil {
+				return fmt.Errorf("failed to store the vote: %w", err)
+			}
+
+			msg := &types.MsgCommitVote{
+				Creator:    entry.Voter,
+				MarketId:   marketId,
+				Commitment: entry.Commitment,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.AddCommand(CmdRevealVote())
+	cmd.Flags().Bool(FlagOverwrite, false, "Replace a stored vote that was never committed on chain")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// hasCommit reports whether voter has committed a vote on the market
+func hasCommit(cmd *cobra.Command, clientCtx client.Context, marketId uint64, voter string) (bool, error) {
+	res, err := types.NewQueryClient(clientCtx).Commits(cmd.Context(), &types.QueryCommitsRequest{MarketId: marketId})
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(res.Commits, func(c types.VoteCommit) bool { return c.Voter == voter }), nil
+}
+
+// CmdRevealVote reveals a vote stored by CmdVote
+func CmdRevealVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal [market-id]",
+		Short: "Reveal a vote committed with the vote command",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id %s: %w", args[0], err)
+			}
+
+			store, err := newVoteStore(clientCtx)
+			if err != nil {
+				return err
+			}
+			entry, err := store.load(marketId)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevealVote{
+				Creator:  entry.Voter,
+				MarketId: entry.MarketId,
+				Vote:     entry.Vote,
+				Nonce:    entry.Nonce,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
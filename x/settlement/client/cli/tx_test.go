@@ -0,0 +1,141 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/settlement/client/cli"
+	settlement "speculod/x/settlement/module"
+	"speculod/x/settlement/types"
+)
+
+func newClientCtx(t *testing.T, home string, kr keyring.Keyring) client.Context {
+	t.Helper()
+	encCfg := moduletestutil.MakeTestEncodingConfig(settlement.AppModule{})
+	return client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig).
+		WithKeyring(kr).
+		WithKeyringDir(home).
+		WithHomeDir(home).
+		WithAccountRetriever(client.MockAccountRetriever{})
+}
+
+func run(t *testing.T, clientCtx client.Context, cmd *cobra.Command, args ...string) (*bytes.Buffer, error) {
+	t.Helper()
+	out := &bytes.Buffer{}
+	cmd.SetArgs(append(args, "--"+flags.FlagFrom, "alice", "--"+flags.FlagGenerateOnly, "--"+flags.FlagOffline,
+		"--"+flags.FlagAccountNumber, "1", "--"+flags.FlagSequence, "1"))
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	clientCtx = clientCtx.WithOutput(out)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	return out, cmd.ExecuteContext(ctx)
+}
+
+// decodeMsg returns the single message of a generated tx
+func decodeMsg(t *testing.T, clientCtx client.Context, out *bytes.Buffer) sdk.Msg {
+	t.Helper()
+	tx, err := clientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	require.NoError(t, err)
+	msgs := tx.GetMsgs()
+	require.Len(t, msgs, 1)
+	return msgs[0]
+}
+
+func TestVoteCommitReveal(t *testing.T) {
+	home := t.TempDir()
+	kr := keyring.NewInMemory(moduletestutil.MakeTestEncodingConfig(settlement.AppModule{}).Codec)
+	_, _, err := kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	clientCtx := newClientCtx(t, home, kr)
+
+	out, err := run(t, clientCtx, cli.CmdVote(), "4", "Yes")
+	require.NoError(t, err, out.String())
+	commit, ok := decodeMsg(t, clientCtx, out).(*types.MsgCommitVote)
+	require.True(t, ok)
+	require.Equal(t, uint64(4), commit.MarketId)
+
+	// The stored entry is encrypted. Offline txs have no chain id, so the
+	// entry is stored right under the address.
+	files, err := filepath.Glob(filepath.Join(home, "votes", "*", "4.vote"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	sealed, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.False(t, json.Valid(sealed))
+	require.NotContains(t, string(sealed), "Yes")
+
+	// Another vote on the same market would lose the stored nonce
+	_, err = run(t, clientCtx, cli.CmdVote(), "4", "No")
+	require.ErrorContains(t, err, "already stored")
+
+	// The same vote broadcasts the stored commitment again, as when the
+	// first commit tx failed
+	out, err = run(t, clientCtx, cli.CmdVote(), "4", "Yes")
+	require.NoError(t, err, out.String())
+	retry, ok := decodeMsg(t, clientCtx, out).(*types.MsgCommitVote)
+	require.True(t, ok)
+	require.Equal(t, commit, retry)
+
+	// --overwrite replaces the stored vote
+	out, err = run(t, clientCtx, cli.CmdVote(), "4", "No", "--"+cli.FlagOverwrite)
+	require.NoError(t, err, out.String())
+	commit, ok = decodeMsg(t, clientCtx, out).(*types.MsgCommitVote)
+	require.True(t, ok)
+	require.NotEqual(t, retry.Commitment, commit.Commitment)
+
+	out, err = run(t, clientCtx, cli.CmdVote(), "reveal", "4")
+	require.NoError(t, err, out.String())
+	reveal, ok := decodeMsg(t, clientCtx, out).(*types.MsgRevealVote)
+	require.True(t, ok)
+	require.Equal(t, commit.Creator, reveal.Creator)
+	require.Equal(t, "No", reveal.Vote)
+	require.Len(t, reveal.Nonce, 64)
+	require.Equal(t, commit.Commitment, types.VoteCommitment(reveal.Vote, reveal.Nonce))
+
+	_, err = run(t, clientCtx, cli.CmdRevealVote(), "5")
+	require.ErrorContains(t, err, "no vote stored for market 5")
+
+	// Another key cannot read the stored vote, even when it is moved under its address
+	other := keyring.NewInMemory(moduletestutil.MakeTestEncodingConfig(settlement.AppModule{}).Codec)
+	record, _, err := other.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	dir := filepath.Join(home, "votes", addr.String())
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "4.vote"), sealed, 0o600))
+	_, err = run(t, newClientCtx(t, home, other), cli.CmdRevealVote(), "4")
+	require.ErrorContains(t, err, "failed to decrypt")
+}
+
+func TestVoteRequiresLocalKey(t *testing.T) {
+	home := t.TempDir()
+	kr := keyring.NewInMemory(moduletestutil.MakeTestEncodingConfig(settlement.AppModule{}).Codec)
+	local, _, err := kr.NewMnemonic("local", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	pubKey, err := local.GetPubKey()
+	require.NoError(t, err)
+	_, err = kr.SaveOfflineKey("alice", pubKey)
+	require.NoError(t, err)
+
+	_, err = run(t, newClientCtx(t, home, kr), cli.CmdVote(), "4", "Yes")
+	require.ErrorContains(t, err, "the vote store only supports local keys, alice is a key of type offline")
+	_, err = os.Stat(filepath.Join(home, "votes"))
+	require.True(t, os.IsNotExist(err))
+}
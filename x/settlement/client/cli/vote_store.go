@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// voteStoreKeyMessage is signed by the voter's key to derive the key
+// encrypting its stored votes
+const voteStoreKeyMessage = "speculod settlement vote store v1"
+
+// voteEntry is a committed vote waiting to be revealed
+type voteEntry struct {
+	MarketId   uint64 `json:"market_id"`
+	Voter      string `json:"voter"`
+	Vote       string `json:"vote"`
+	Nonce      string `json:"nonce"`
+	Commitment string `json:"commitment"`
+}
+
+// voteStore keeps the votes of a voter on one chain, one file per market,
+// sealed with AES-256-GCM.
+type voteStore struct {
+	dir string
+	key []byte
+}
+
+// newVoteStore opens the vote store of the key signing clientCtx's txs. The
+// encryption key is the hash of a signature of a fixed message, so only the
+// holder of the voting key can read its nonces; the signature is
+// deterministic for secp256k1 and ed25519 keys. Only local keys are
+// supported: a Ledger device cannot sign arbitrary bytes in SIGN_MODE_DIRECT
+// and offline or multisig keys cannot sign at all.
+func newVoteStore(clientCtx client.Context) (*voteStore, error) {
+	if clientCtx.Keyring == nil || clientCtx.FromName == "" {
+		return nil, errors.New("a key from the keyring is required, set it with --from")
+	}
+	record, err := clientCtx.Keyring.Key(clientCtx.FromName)
+	if err != nil {
+		return nil, err
+	}
+	if record.GetType() != keyring.TypeLocal {
+		return nil, fmt.Errorf("the vote store only supports local keys, %s is a key of type %s", clientCtx.FromName, record.GetType())
+	}
+	sig, _, err := clientCtx.Keyring.Sign(clientCtx.FromName, []byte(voteStoreKeyMessage), signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive the vote store key of %s: %w", clientCtx.FromName, err)
+	}
+	key := sha256.Sum256(sig)
+	dir := filepath.Join(clientCtx.HomeDir, "votes", clientCtx.ChainID, clientCtx.GetFromAddress().String())
+	return &voteStore{dir: dir, key: key[:]}, nil
+}
+
+func (s *voteStore) path(marketId uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(marketId, 10)+".vote")
+}
+
+func (s *voteStore) aead() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// has reports whether a vote is stored for a market
+func (s *voteStore) has(marketId uint64) bool {
+	_, err := os.Stat(s.path(marketId))
+	return err == nil
+}
+
+// save stores the vote of a market. The file is written to a temporary path
+// first, so an interrupted write never replaces a stored nonce.
+func (s *voteStore) save(entry voteEntry) error {
+	plaintext, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	aead, err := s.aead()
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, []byte(strconv.FormatUint(entry.MarketId, 10)))
+
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+	tmp := s.path(entry.MarketId) + ".tmp"
+	if err := os.WriteFile(tmp, sealed, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path(entry.MarketId))
+}
+
+// load reads the vote stored for a market
+func (s *voteStore) load(marketId uint64) (voteEntry, error) {
+	sealed, err := os.ReadFile(s.path(marketId))
+	if errors.Is(err, os.ErrNotExist) {
+		return voteEntry{}, fmt.Errorf("no vote stored for market %d in %s", marketId, s.dir)
+	} else if err != nil {
+		return voteEntry{}, err
+	}
+	aead, err := s.aead()
+	if err != nil {
+		return voteEntry{}, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return voteEntry{}, fmt.Errorf("vote of market %d is corrupted", marketId)
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(strconv.FormatUint(marketId, 10)))
+	if err != nil {
+		return voteEntry{}, fmt.Errorf("failed to decrypt the vote of market %d, was it stored with another key?", marketId)
+	}
+	var entry voteEntry
+	if err := json.Unmarshal(plaintext, &entry); err != nil {
+		return voteEntry{}, err
+	}
+	return entry, nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/settlement/keeper"
+	"speculod/x/settlement/types"
+)
+
+func TestTypedEvents(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	hash := sha256.Sum256([]byte("Yes" + "nonce-123"))
+	_, err := ms.CommitVote(ctx, &types.MsgCommitVote{
+		Creator:    "alice",
+		MarketId:   1,
+		Commitment: hex.EncodeToString(hash[:]),
+	})
+	require.NoError(t, err)
+	_, err = ms.RevealVote(ctx, &types.MsgRevealVote{
+		Creator:  "alice",
+		MarketId: 1,
+		Vote:     "Yes",
+		Nonce:    "nonce-123",
+	})
+	require.NoError(t, err)
+	_, err = ms.FinalizeOutcome(ctx, &types.MsgFinalizeOutcome{Creator: "bob", MarketId: 1})
+	require.NoError(t, err)
+
+	var got []any
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		got = append(got, msg)
+	}
+	require.Equal(t, []any{
+		&types.EventVoteCommitted{MarketId: 1, Voter: "alice"},
+		&types.EventVoteRevealed{MarketId: 1, Voter: "alice", Vote: "Yes"},
+		&types.EventOutcomeFinalized{MarketId: 1, Outcome: "Yes", TotalVotes: 1, OutcomeWeight: 10},
+	}, got)
+}
+
+func TestFinalizeOutcomeTieBreak(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	// Every voter has the same reputation, so the votes tie
+	for voter, vote := range map[string]string{"alice": "Yes", "bob": "No"} {
+		nonce := "nonce-" + voter
+		hash := sha256.Sum256([]byte(vote + nonce))
+		_, err := ms.CommitVote(ctx, &types.MsgCommitVote{Creator: voter, MarketId: 1, Commitment: hex.EncodeToString(hash[:])})
+		require.NoError(t, err)
+		_, err = ms.RevealVote(ctx, &types.MsgRevealVote{Creator: voter, MarketId: 1, Vote: vote, Nonce: nonce})
+		require.NoError(t, err)
+	}
+	_, err := ms.FinalizeOutcome(ctx, &types.MsgFinalizeOutcome{Creator: "carol", MarketId: 1})
+	require.NoError(t, err)
+
+	outcome, found := f.keeper.GetOutcome(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, "No", outcome)
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"speculod/x/settlement/keeper"
+	module "speculod/x/settlement/module"
+	"speculod/x/settlement/types"
+)
+
+func TestCommitVoteRequiresGroupParticipant(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	// Only alice is a participant of the market group
+	ctrl := gomock.NewController(t)
+	predictionKeeper := newMockPredictionKeeper(ctrl)
+	predictionKeeper.EXPECT().IsGroupParticipant(gomock.Any(), "test-group", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, address string) bool { return address == "alice" }).AnyTimes()
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		predictionKeeper,
+		newMockReputationKeeper(ctrl),
+	)
+	ms := keeper.NewMsgServerImpl(k)
+
+	hash := sha256.Sum256([]byte("Yes" + "nonce"))
+	commit := func(voter string) error {
+		_, err := ms.CommitVote(ctx, &types.MsgCommitVote{
+			Creator:    voter,
+			MarketId:   1,
+			Commitment: hex.EncodeToString(hash[:]),
+		})
+		return err
+	}
+	require.ErrorIs(t, commit("mallory"), types.ErrNotGroupParticipant)
+	require.NoError(t, commit("alice"))
+}
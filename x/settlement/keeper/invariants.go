@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	settlementtypes "speculod/x/settlement/types"
+)
+
+// RegisterInvariants registers all settlement invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(settlementtypes.ModuleName, "reveal-commits", RevealCommitInvariant(k))
+}
+
+// AllInvariants runs all invariants of the settlement module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return RevealCommitInvariant(k)
+}
+
+// AssertInvariants returns an error describing the first broken invariant
+func (k Keeper) AssertInvariants(ctx sdk.Context) error {
+	if msg, broken := AllInvariants(k)(ctx); broken {
+		return errorsmod.Wrap(settlementtypes.ErrInvariantBroken, msg)
+	}
+	return nil
+}
+
+// RevealCommitInvariant checks that every reveal has a matching commit
+func RevealCommitInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		err := k.Reveals.Walk(ctx, nil, func(key collections.Pair[uint64, string], _ settlementtypes.VoteReveal) (bool, error) {
+			has, err := k.Commits.Has(ctx, key)
+			if err != nil {
+				return true, err
+			}
+			if !has {
+				broken = true
+				msg += fmt.Sprintf("\tmarket %d: reveal of %s has no commit\n", key.K1(), key.K2())
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(settlementtypes.ModuleName, "reveal-commits", err.Error()), true
+		}
+		return sdk.FormatInvariant(settlementtypes.ModuleName, "reveal-commits", msg), broken
+	}
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/settlement/keeper"
+	"speculod/x/settlement/types"
+)
+
+func TestRevealCommitInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	f.keeper.SetCommit(ctx, types.VoteCommit{MarketId: 1, Voter: "alice", Commitment: "c"})
+	f.keeper.SetReveal(ctx, types.VoteReveal{MarketId: 1, Voter: "alice", Vote: "Yes", Nonce: "n"})
+	require.NoError(t, f.keeper.AssertInvariants(ctx))
+
+	f.keeper.SetReveal(ctx, types.VoteReveal{MarketId: 2, Voter: "bob", Vote: "No", Nonce: "n"})
+	msg, broken := keeper.RevealCommitInvariant(f.keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "market 2: reveal of bob has no commit")
+	require.ErrorIs(t, f.keeper.AssertInvariants(ctx), types.ErrInvariantBroken)
+}
@@ -14,8 +14,8 @@ import (
 )
 
 // Helper to build composite key for (market_id, voter)
-func MarketVoterKey(marketId uint64, voter string) string {
-	return fmt.Sprintf("%d/%s", marketId, voter)
+func MarketVoterKey(marketId uint64, voter string) collections.Pair[uint64, string] {
+	return collections.Join(marketId, voter)
 }
 
 // Keeper struct (add fields for settlement storage)
@@ -34,8 +34,8 @@ type Keeper struct {
 	Schema collections.Schema
 
 	Params   collections.Item[settlementtypes.Params]
-	Commits  collections.Map[string, settlementtypes.VoteCommit]
-	Reveals  collections.Map[string, settlementtypes.VoteReveal]
+	Commits  collections.Map[collections.Pair[uint64, string], settlementtypes.VoteCommit]
+	Reveals  collections.Map[collections.Pair[uint64, string], settlementtypes.VoteReveal]
 	Outcomes collections.Map[uint64, string]
 }
 
@@ -61,9 +61,11 @@ func NewKeeper(
 		predictionKeeper: predictionKeeper,
 		reputationKeeper: reputationKeeper,
 
-		Params:   collections.NewItem(sb, collections.NewPrefix("params"), "params", codec.CollValue[settlementtypes.Params](cdc)),
-		Commits:  collections.NewMap(sb, collections.NewPrefix("commits"), "commits", collections.StringKey, codec.CollValue[settlementtypes.VoteCommit](cdc)),
-		Reveals:  collections.NewMap(sb, collections.NewPrefix("reveals"), "reveals", collections.StringKey, codec.CollValue[settlementtypes.VoteReveal](cdc)),
+		Params: collections.NewItem(sb, collections.NewPrefix("params"), "params", codec.CollValue[settlementtypes.Params](cdc)),
+		Commits: collections.NewMap(sb, settlementtypes.CommitsKey, "commits",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[settlementtypes.VoteCommit](cdc)),
+		Reveals: collections.NewMap(sb, settlementtypes.RevealsKey, "reveals",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[settlementtypes.VoteReveal](cdc)),
 		Outcomes: collections.NewMap(sb, collections.NewPrefix("outcomes"), "outcomes", collections.Uint64Key, collections.StringValue),
 	}
 
@@ -152,22 +154,11 @@ func (k Keeper) GetOutcome(ctx sdk.Context, marketId uint64) (string, bool) {
 // GetAllReveals returns all reveals for a market
 func (k Keeper) GetAllReveals(ctx sdk.Context, marketId uint64) []settlementtypes.VoteReveal {
 	var reveals []settlementtypes.VoteReveal
-	iterator, err := k.Reveals.Iterate(ctx, nil)
-	if err != nil {
-		return reveals
-	}
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		key, err := iterator.Key()
-		if err != nil {
-			continue
-		}
-		if len(key) > 0 && key[:len(fmt.Sprintf("%d/", marketId))] == fmt.Sprintf("%d/", marketId) {
-			val, err := iterator.Value()
-			if err == nil {
-				reveals = append(reveals, val)
-			}
-		}
+	if err := k.Reveals.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](marketId), func(_ collections.Pair[uint64, string], reveal settlementtypes.VoteReveal) (bool, error) {
+		reveals = append(reveals, reveal)
+		return false, nil
+	}); err != nil {
+		return nil
 	}
 	return reveals
 }
@@ -256,22 +247,11 @@ func (k Keeper) GetSettlementStats(ctx sdk.Context, marketId uint64) (*settlemen
 // GetAllCommits returns all commits for a market
 func (k Keeper) GetAllCommits(ctx sdk.Context, marketId uint64) []settlementtypes.VoteCommit {
 	var commits []settlementtypes.VoteCommit
-	iterator, err := k.Commits.Iterate(ctx, nil)
-	if err != nil {
-		return commits
-	}
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		key, err := iterator.Key()
-		if err != nil {
-			continue
-		}
-		if len(key) > 0 && key[:len(fmt.Sprintf("%d/", marketId))] == fmt.Sprintf("%d/", marketId) {
-			val, err := iterator.Value()
-			if err == nil {
-				commits = append(commits, val)
-			}
-		}
+	if err := k.Commits.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](marketId), func(_ collections.Pair[uint64, string], commit settlementtypes.VoteCommit) (bool, error) {
+		commits = append(commits, commit)
+		return false, nil
+	}); err != nil {
+		return nil
 	}
 	return commits
 }
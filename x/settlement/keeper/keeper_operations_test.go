@@ -5,6 +5,7 @@ import (
 
 	"speculod/x/settlement/types"
 
+	"cosmossdk.io/collections"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,7 +26,7 @@ func TestKeeperOperations_CommitStorage(t *testing.T) {
 
 	// Test key generation
 	key := MarketVoterKey(commit.MarketId, commit.Voter)
-	expectedKey := "1/alice"
+	expectedKey := collections.Join(uint64(1), "alice")
 	require.Equal(t, expectedKey, key, "Key should be correctly formatted")
 
 	// Test multiple commits for same market
@@ -36,7 +37,7 @@ func TestKeeperOperations_CommitStorage(t *testing.T) {
 	}
 
 	key2 := MarketVoterKey(commit2.MarketId, commit2.Voter)
-	expectedKey2 := "1/bob"
+	expectedKey2 := collections.Join(uint64(1), "bob")
 	require.Equal(t, expectedKey2, key2, "Second key should be correctly formatted")
 	require.NotEqual(t, key, key2, "Different voters should have different keys")
 }
@@ -59,7 +60,7 @@ func TestKeeperOperations_RevealStorage(t *testing.T) {
 
 	// Test key generation
 	key := MarketVoterKey(reveal.MarketId, reveal.Voter)
-	expectedKey := "1/alice"
+	expectedKey := collections.Join(uint64(1), "alice")
 	require.Equal(t, expectedKey, key, "Key should be correctly formatted")
 
 	// Test multiple reveals for same market
@@ -71,7 +72,7 @@ func TestKeeperOperations_RevealStorage(t *testing.T) {
 	}
 
 	key2 := MarketVoterKey(reveal2.MarketId, reveal2.Voter)
-	expectedKey2 := "1/bob"
+	expectedKey2 := collections.Join(uint64(1), "bob")
 	require.Equal(t, expectedKey2, key2, "Second key should be correctly formatted")
 	require.NotEqual(t, key, key2, "Different voters should have different keys")
 }
@@ -108,8 +109,8 @@ func TestKeeperOperations_KeyGeneration(t *testing.T) {
 	key1 := MarketVoterKey(market1ID, voter)
 	key2 := MarketVoterKey(market2ID, voter)
 
-	require.Equal(t, "1/alice", key1, "Key for market 1 should be correctly formatted")
-	require.Equal(t, "2/alice", key2, "Key for market 2 should be correctly formatted")
+	require.Equal(t, collections.Join(uint64(1), "alice"), key1, "Key for market 1 should be correctly formatted")
+	require.Equal(t, collections.Join(uint64(2), "alice"), key2, "Key for market 2 should be correctly formatted")
 	require.NotEqual(t, key1, key2, "Different markets should have different keys")
 
 	// Test different voters
@@ -120,8 +121,8 @@ func TestKeeperOperations_KeyGeneration(t *testing.T) {
 	key3 := MarketVoterKey(marketID, voter1)
 	key4 := MarketVoterKey(marketID, voter2)
 
-	require.Equal(t, "1/alice", key3, "Key for alice should be correctly formatted")
-	require.Equal(t, "1/bob", key4, "Key for bob should be correctly formatted")
+	require.Equal(t, collections.Join(uint64(1), "alice"), key3, "Key for alice should be correctly formatted")
+	require.Equal(t, collections.Join(uint64(1), "bob"), key4, "Key for bob should be correctly formatted")
 	require.NotEqual(t, key3, key4, "Different voters should have different keys")
 }
 
@@ -233,13 +234,13 @@ func TestKeeperOperations_ErrorHandling(t *testing.T) {
 	// Test invalid market ID
 	invalidMarketID := uint64(0)
 	key := MarketVoterKey(invalidMarketID, "alice")
-	expectedKey := "0/alice"
+	expectedKey := collections.Join(uint64(0), "alice")
 	require.Equal(t, expectedKey, key, "Key should be generated even for invalid market ID")
 
 	// Test empty voter
 	emptyVoter := ""
 	key2 := MarketVoterKey(1, emptyVoter)
-	expectedKey2 := "1/"
+	expectedKey2 := collections.Join(uint64(1), "")
 	require.Equal(t, expectedKey2, key2, "Key should be generated even for empty voter")
 
 	// Test invalid commitment length
@@ -261,13 +262,13 @@ func TestKeeperOperations_Performance(t *testing.T) {
 	voter := "alice"
 
 	// Generate multiple keys
-	keys := make([]string, 100)
+	keys := make([]collections.Pair[uint64, string], 100)
 	for i := 0; i < 100; i++ {
 		keys[i] = MarketVoterKey(marketID, voter)
 	}
 
 	// Verify all keys are the same
-	expectedKey := "1/alice"
+	expectedKey := collections.Join(uint64(1), "alice")
 	for i, key := range keys {
 		require.Equal(t, expectedKey, key, "All keys should be identical")
 		if i > 0 {
@@ -12,9 +12,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"go.uber.org/mock/gomock"
 
+	predictiontypes "speculod/x/prediction/types"
 	"speculod/x/settlement/keeper"
 	module "speculod/x/settlement/module"
+	settlementtestutil "speculod/x/settlement/testutil"
 	"speculod/x/settlement/types"
 )
 
@@ -36,13 +39,17 @@ func initFixture(t *testing.T) *fixture {
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 
+	ctrl := gomock.NewController(t)
+	predictionKeeper := newMockPredictionKeeper(ctrl)
+	predictionKeeper.EXPECT().IsGroupParticipant(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
-		&keeper.MockPredictionKeeper{},
-		&keeper.MockReputationKeeper{},
+		predictionKeeper,
+		newMockReputationKeeper(ctrl),
 	)
 
 	// Initialize params
@@ -56,3 +63,33 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec: addressCodec,
 	}
 }
+
+// newMockPredictionKeeper returns a prediction keeper mock where every market
+// exists, has the outcomes Yes and No and resolves successfully. Group
+// participation is left to the test.
+func newMockPredictionKeeper(ctrl *gomock.Controller) *settlementtestutil.MockPredictionKeeper {
+	m := settlementtestutil.NewMockPredictionKeeper(ctrl)
+	m.EXPECT().GetPredictionMarket(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, marketId uint64) (predictiontypes.PredictionMarket, bool) {
+			return predictiontypes.PredictionMarket{
+				Id:       marketId,
+				Question: "Test question",
+				Outcomes: []string{"Yes", "No"},
+				GroupId:  "test-group",
+				Status:   predictiontypes.MarketStatusClosed,
+				Creator:  "test-creator",
+			}, true
+		}).AnyTimes()
+	m.EXPECT().ValidateOutcome(gomock.Any(), gomock.Any()).DoAndReturn(predictiontypes.ValidateOutcome).AnyTimes()
+	m.EXPECT().ResolveMarket(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return m
+}
+
+// newMockReputationKeeper returns a reputation keeper mock where every voter
+// has a score of 10 and adjustments succeed
+func newMockReputationKeeper(ctrl *gomock.Controller) *settlementtestutil.MockReputationKeeper {
+	m := settlementtestutil.NewMockReputationKeeper(ctrl)
+	m.EXPECT().GetReputationScore(gomock.Any(), gomock.Any(), gomock.Any()).Return("10", true).AnyTimes()
+	m.EXPECT().AdjustReputationScore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return m
+}
@@ -2,9 +2,6 @@ package keeper
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
-	"strconv"
 
 	"speculod/x/settlement/types"
 
@@ -36,6 +33,11 @@ func (k msgServer) CommitVote(goCtx context.Context, msg *types.MsgCommitVote) (
 		return nil, sdkerrors.Wrap(types.ErrMarketNotReady, "market not ready for settlement")
 	}
 
+	// Votes on grouped markets are restricted to the group's participants
+	if market.GroupId != "" && !k.predictionKeeper.IsGroupParticipant(ctx, market.GroupId, msg.Creator) {
+		return nil, sdkerrors.Wrapf(types.ErrNotGroupParticipant, "%s is not a participant of group %s", msg.Creator, market.GroupId)
+	}
+
 	// Prevent double-commit
 	_, found = k.GetCommit(ctx, msg.MarketId, msg.Creator)
 	if found {
@@ -54,14 +56,12 @@ func (k msgServer) CommitVote(goCtx context.Context, msg *types.MsgCommitVote) (
 	}
 	k.SetCommit(ctx, commit)
 
-	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"vote_committed",
-			sdk.NewAttribute("market_id", strconv.FormatUint(msg.MarketId, 10)),
-			sdk.NewAttribute("voter", msg.Creator),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVoteCommitted{
+		MarketId: msg.MarketId,
+		Voter:    msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCommitVoteResponse{}, nil
 }
@@ -76,7 +76,7 @@ func (k msgServer) RevealVote(goCtx context.Context, msg *types.MsgRevealVote) (
 	}
 
 	// Validate commitment matches reveal
-	expectedCommitment := generateCommitment(msg.Vote, msg.Nonce)
+	expectedCommitment := types.VoteCommitment(msg.Vote, msg.Nonce)
 	if commit.Commitment != expectedCommitment {
 		return nil, sdkerrors.Wrap(types.ErrCommitmentMismatch, "commitment does not match reveal")
 	}
@@ -115,15 +115,13 @@ func (k msgServer) RevealVote(goCtx context.Context, msg *types.MsgRevealVote) (
 	}
 	k.SetReveal(ctx, reveal)
 
-	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"vote_revealed",
-			sdk.NewAttribute("market_id", strconv.FormatUint(msg.MarketId, 10)),
-			sdk.NewAttribute("voter", msg.Creator),
-			sdk.NewAttribute("vote", msg.Vote),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVoteRevealed{
+		MarketId: msg.MarketId,
+		Voter:    msg.Creator,
+		Vote:     msg.Vote,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgRevealVoteResponse{}, nil
 }
@@ -155,11 +153,13 @@ func (k msgServer) FinalizeOutcome(goCtx context.Context, msg *types.MsgFinalize
 	// Calculate reputation-weighted votes
 	voteWeights := k.GetReputationWeightedVotes(ctx, msg.MarketId, groupId)
 
-	// Find the outcome with the highest weighted votes
+	// Find the outcome with the highest weighted votes. Ties go to the
+	// lexicographically smallest outcome so the result does not depend on the
+	// map iteration order.
 	var consensus string
 	maxWeight := int64(0)
 	for outcome, weight := range voteWeights {
-		if weight > maxWeight {
+		if weight > maxWeight || (weight == maxWeight && outcome < consensus) {
 			maxWeight = weight
 			consensus = outcome
 		}
@@ -167,19 +167,21 @@ func (k msgServer) FinalizeOutcome(goCtx context.Context, msg *types.MsgFinalize
 
 	// Set the final outcome
 	k.SetOutcome(ctx, msg.MarketId, consensus)
+	if err := k.predictionKeeper.ResolveMarket(ctx, msg.MarketId, consensus); err != nil {
+		return nil, err
+	}
 
 	// Update reputation scores based on voting accuracy
 	k.updateReputationScores(ctx, msg.MarketId, groupId, consensus, reveals)
 
-	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"outcome_finalized",
-			sdk.NewAttribute("market_id", strconv.FormatUint(msg.MarketId, 10)),
-			sdk.NewAttribute("final_outcome", consensus),
-			sdk.NewAttribute("total_votes", strconv.Itoa(len(reveals))),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOutcomeFinalized{
+		MarketId:      msg.MarketId,
+		Outcome:       consensus,
+		TotalVotes:    uint32(len(reveals)),
+		OutcomeWeight: maxWeight,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgFinalizeOutcomeResponse{}, nil
 }
@@ -205,13 +207,6 @@ func (k msgServer) updateReputationScores(ctx sdk.Context, marketId uint64, grou
 	}
 }
 
-// Helper to generate commitment hash
-func generateCommitment(vote, nonce string) string {
-	data := vote + nonce
-	hash := sha256.Sum256([]byte(data))
-	return hex.EncodeToString(hash[:])
-}
-
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"speculod/x/settlement/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. Commits and
+// reveals were stored under "marketId/voter" string keys, so listing the
+// votes of market 1 also matched markets 10, 11, ...; v2 stores them under
+// (marketId, voter) pairs.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	pairKey := collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)
+
+	legacyCommits := collections.NewMap(sb, types.CommitsKey, "commits", collections.StringKey, codec.CollValue[types.VoteCommit](cdc))
+	commits := collections.NewMap(sb, types.CommitsKey, "commits", pairKey, codec.CollValue[types.VoteCommit](cdc))
+	if err := migrateMap(ctx, legacyCommits, commits, func(c types.VoteCommit) collections.Pair[uint64, string] {
+		return collections.Join(c.MarketId, c.Voter)
+	}); err != nil {
+		return err
+	}
+
+	legacyReveals := collections.NewMap(sb, types.RevealsKey, "reveals", collections.StringKey, codec.CollValue[types.VoteReveal](cdc))
+	reveals := collections.NewMap(sb, types.RevealsKey, "reveals", pairKey, codec.CollValue[types.VoteReveal](cdc))
+	return migrateMap(ctx, legacyReveals, reveals, func(r types.VoteReveal) collections.Pair[uint64, string] {
+		return collections.Join(r.MarketId, r.Voter)
+	})
+}
+
+// migrateMap moves every entry of legacy to the key returned by keyOf in m.
+// Both maps share a prefix, so the entries are collected before rewriting.
+func migrateMap[V any](
+	ctx context.Context,
+	legacy collections.Map[string, V],
+	m collections.Map[collections.Pair[uint64, string], V],
+	keyOf func(V) collections.Pair[uint64, string],
+) error {
+	kvs, err := legacy.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	entries, err := kvs.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range entries {
+		if err := legacy.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+		if err := m.Set(ctx, keyOf(kv.Value), kv.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package v2_test
+
+import (
+	"fmt"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/settlement/keeper"
+	v2 "speculod/x/settlement/migrations/v2"
+	module "speculod/x/settlement/module"
+	"speculod/x/settlement/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	// v1 stored commits and reveals under "marketId/voter" string keys
+	commits := []types.VoteCommit{
+		{MarketId: 1, Voter: "alice", Commitment: "aa"},
+		{MarketId: 1, Voter: "bob", Commitment: "bb"},
+		{MarketId: 10, Voter: "alice", Commitment: "cc"},
+	}
+	reveals := []types.VoteReveal{
+		{MarketId: 1, Voter: "alice", Vote: "Yes", Nonce: "n1"},
+		{MarketId: 10, Voter: "alice", Vote: "No", Nonce: "n2"},
+	}
+	store := ctx.KVStore(storeKey)
+	legacyKey := func(prefix []byte, marketId uint64, voter string) []byte {
+		return append(append([]byte{}, prefix...), fmt.Sprintf("%d/%s", marketId, voter)...)
+	}
+	for _, commit := range commits {
+		store.Set(legacyKey(types.CommitsKey, commit.MarketId, commit.Voter), cdc.MustMarshal(&commit))
+	}
+	for _, reveal := range reveals {
+		store.Set(legacyKey(types.RevealsKey, reveal.MarketId, reveal.Voter), cdc.MustMarshal(&reveal))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	k := keeper.NewKeeper(storeService, cdc, addressCodec, authtypes.NewModuleAddress(types.GovModuleName), nil, nil)
+	commit, found := k.GetCommit(ctx, 10, "alice")
+	require.True(t, found)
+	require.Equal(t, commits[2], commit)
+	reveal, found := k.GetReveal(ctx, 1, "alice")
+	require.True(t, found)
+	require.Equal(t, reveals[0], reveal)
+
+	// Market 1 no longer matches the votes of market 10
+	require.Equal(t, commits[:2], k.GetAllCommits(ctx, 1))
+	require.Equal(t, reveals[:1], k.GetAllReveals(ctx, 1))
+	require.Equal(t, reveals[1:], k.GetAllReveals(ctx, 10))
+
+	for _, commit := range commits {
+		require.False(t, store.Has(legacyKey(types.CommitsKey, commit.MarketId, commit.Voter)))
+	}
+	for _, reveal := range reveals {
+		require.False(t, store.Has(legacyKey(types.RevealsKey, reveal.MarketId, reveal.Voter)))
+	}
+}
@@ -17,6 +17,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "Commits",
+					Use:            "commits [market-id]",
+					Short:          "List the vote commits of a market",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}},
+				},
+				{
+					RpcMethod:      "Reveals",
+					Use:            "reveals [market-id]",
+					Short:          "List the vote reveals of a market",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}},
+				},
+				{
+					RpcMethod:      "Outcome",
+					Use:            "outcome [market-id]",
+					Short:          "Show the finalized outcome of a market",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +46,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod:      "CommitVote",
+					Use:            "commit-vote [market-id] [commitment]",
+					Short:          "Commit the hex encoded sha256 of a vote followed by a nonce",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "commitment"}},
+				},
+				{
+					RpcMethod:      "RevealVote",
+					Use:            "reveal-vote [market-id] [vote] [nonce]",
+					Short:          "Reveal a committed vote",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "vote"}, {ProtoField: "nonce"}},
+				},
+				{
+					RpcMethod:      "FinalizeOutcome",
+					Use:            "finalize-outcome [market-id]",
+					Short:          "Finalize the outcome of a market from its revealed votes",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -61,7 +61,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.PredictionKeeper,
 		in.ReputationKeeper,
 	)
-	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
+	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper, in.PredictionKeeper)
 
 	return ModuleOutputs{SettlementKeeper: k, Module: m}
 }
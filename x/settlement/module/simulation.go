@@ -1,12 +1,27 @@
 package settlement
 
 import (
+	"math/rand"
+
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	settlementsimulation "speculod/x/settlement/simulation"
 	"speculod/x/settlement/types"
 )
 
+const (
+	opWeightMsgCommitVote          = "op_weight_msg_commit_vote"
+	defaultWeightMsgCommitVote int = 50
+
+	opWeightMsgRevealVote          = "op_weight_msg_reveal_vote"
+	defaultWeightMsgRevealVote int = 50
+
+	opWeightMsgFinalizeOutcome          = "op_weight_msg_finalize_outcome"
+	defaultWeightMsgFinalizeOutcome int = 10
+)
+
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	accs := make([]string, len(simState.Accounts))
@@ -19,12 +34,49 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&settlementGenesis)
 }
 
-// RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+// RegisterStoreDecoder registers a decoder that pretty-prints the values of
+// the module's collections by key prefix.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
+
+	var weightMsgCommitVote int
+	simState.AppParams.GetOrGenerate(opWeightMsgCommitVote, &weightMsgCommitVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgCommitVote = defaultWeightMsgCommitVote
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCommitVote,
+		settlementsimulation.SimulateMsgCommitVote(am.authKeeper, am.bankKeeper, am.keeper, am.predictionKeeper, simState.TxConfig),
+	))
+
+	var weightMsgRevealVote int
+	simState.AppParams.GetOrGenerate(opWeightMsgRevealVote, &weightMsgRevealVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevealVote = defaultWeightMsgRevealVote
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRevealVote,
+		settlementsimulation.SimulateMsgRevealVote(am.authKeeper, am.bankKeeper, am.keeper, am.predictionKeeper, simState.TxConfig),
+	))
+
+	var weightMsgFinalizeOutcome int
+	simState.AppParams.GetOrGenerate(opWeightMsgFinalizeOutcome, &weightMsgFinalizeOutcome, nil,
+		func(_ *rand.Rand) {
+			weightMsgFinalizeOutcome = defaultWeightMsgFinalizeOutcome
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFinalizeOutcome,
+		settlementsimulation.SimulateMsgFinalizeOutcome(am.authKeeper, am.bankKeeper, am.keeper, am.predictionKeeper, simState.TxConfig),
+	))
+
 	return operations
 }
 
@@ -0,0 +1,61 @@
+package settlement_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/settlement/keeper"
+	settlement "speculod/x/settlement/module"
+	"speculod/x/settlement/types"
+)
+
+func TestStoreDecoder(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(settlement.AppModule{}).Codec
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storetypes.NewKVStoreKey(types.StoreKey)),
+		cdc,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil, nil,
+	)
+	sdr := make(simtypes.StoreDecoderRegistry)
+	settlement.NewAppModule(cdc, k, nil, nil, nil).RegisterStoreDecoder(sdr)
+	dec := sdr[types.StoreKey]
+
+	commit := types.VoteCommit{MarketId: 1, Voter: "alice", Commitment: "aa"}
+	reveal := types.VoteReveal{MarketId: 1, Voter: "alice", Vote: "Yes", Nonce: "nonce-123"}
+
+	commitKey, err := collections.EncodeKeyWithPrefix(k.Commits.GetPrefix(), k.Commits.KeyCodec(), collections.Join(uint64(1), "alice"))
+	require.NoError(t, err)
+	revealKey, err := collections.EncodeKeyWithPrefix(k.Reveals.GetPrefix(), k.Reveals.KeyCodec(), collections.Join(uint64(1), "alice"))
+	require.NoError(t, err)
+	outcomeKey, err := collections.EncodeKeyWithPrefix(k.Outcomes.GetPrefix(), k.Outcomes.KeyCodec(), 1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		kvA, kvB    kv.Pair
+		expectedLog string
+	}{
+		{"commit", kv.Pair{Key: commitKey, Value: cdc.MustMarshal(&commit)}, kv.Pair{Key: commitKey, Value: cdc.MustMarshal(&commit)}, commit.String() + "\n" + commit.String()},
+		{"reveal", kv.Pair{Key: revealKey, Value: cdc.MustMarshal(&reveal)}, kv.Pair{Key: revealKey, Value: cdc.MustMarshal(&types.VoteReveal{})}, reveal.String() + "\n"},
+		{"outcome", kv.Pair{Key: outcomeKey, Value: []byte("Yes")}, kv.Pair{Key: outcomeKey, Value: []byte("No")}, "Yes\nNo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLog, dec(tt.kvA, tt.kvB))
+		})
+	}
+
+	require.Panics(t, func() { dec(kv.Pair{Key: []byte("unknown")}, kv.Pair{Key: []byte("unknown")}) })
+}
@@ -0,0 +1,57 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/settlement/keeper"
+	"speculod/x/settlement/types"
+)
+
+func SimulateMsgCommitVote(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	pk types.PredictionKeeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgCommitVote{
+			Creator: simAccount.Address.String(),
+		}
+
+		markets := unsettledMarkets(ctx, k, pk)
+		if len(markets) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no market to settle"), nil, nil
+		}
+		market := markets[r.Intn(len(markets))]
+		if _, found := k.GetCommit(ctx, market.Id, msg.Creator); found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "already committed"), nil, nil
+		}
+		vote := market.Outcomes[r.Intn(len(market.Outcomes))]
+		msg.MarketId = market.Id
+		msg.Commitment = types.VoteCommitment(vote, nonce(market.Id, msg.Creator))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,40 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	predictiontypes "speculod/x/prediction/types"
+	"speculod/x/settlement/keeper"
+	"speculod/x/settlement/types"
+)
+
+// nonce derives the reveal nonce of a voter from the market and voter, so the
+// reveal operation can recompute it without keeping state between operations
+func nonce(marketId uint64, voter string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s", marketId, voter)))
+	return hex.EncodeToString(hash[:16])
+}
+
+// unsettledMarkets returns the markets past their deadline whose outcome is
+// not finalized yet. Market ids are sequential, so the markets are listed by
+// looking ids up until one is missing.
+func unsettledMarkets(ctx sdk.Context, k keeper.Keeper, pk types.PredictionKeeper) []predictiontypes.PredictionMarket {
+	var markets []predictiontypes.PredictionMarket
+	for id := uint64(0); ; id++ {
+		market, found := pk.GetPredictionMarket(ctx, id)
+		if !found {
+			return markets
+		}
+		// Grouped markets only accept votes from x/group members
+		if market.GroupId != "" {
+			continue
+		}
+		if ready, err := k.IsMarketReadyForSettlement(ctx, id); err == nil && ready {
+			markets = append(markets, market)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"speculod/x/settlement/keeper"
+	"speculod/x/settlement/types"
+)
+
+func SimulateMsgRevealVote(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	pk types.PredictionKeeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgRevealVote{}
+
+		// Find the commits of simulation accounts that are not revealed yet
+		var (
+			reveals []types.MsgRevealVote
+			voters  []simtypes.Account
+		)
+		for _, market := range unsettledMarkets(ctx, k, pk) {
+			for _, commit := range k.GetAllCommits(ctx, market.Id) {
+				if _, found := k.GetReveal(ctx, market.Id, commit.Voter); found {
+					continue
+				}
+				addr, err := sdk.AccAddressFromBech32(commit.Voter)
+				if err != nil {
+					continue
+				}
+				voter, found := simtypes.FindAccount(accs, addr)
+				if !found {
+					continue
+				}
+				n := nonce(market.Id, commit.Voter)
+				for _, outcome := range market.Outcomes {
+					if types.VoteCommitment(outcome, n) == commit.Commitment {
+						reveals = append(reveals, types.MsgRevealVote{Creator: commit.Voter, MarketId: market.Id, Vote: outcome, Nonce: n})
+						voters = append(voters, voter)
+						break
+					}
+				}
+			}
+		}
+		if len(reveals) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no vote to reveal"), nil, nil
+		}
+		i := r.Intn(len(reveals))
+		*msg = reveals[i]
+		simAccount := voters[i]
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
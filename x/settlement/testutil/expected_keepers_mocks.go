@@ -0,0 +1,292 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: x/settlement/types/expected_keepers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=x/settlement/types/expected_keepers.go -package testutil -destination x/settlement/testutil/expected_keepers_mocks.go
+//
+
+// Package testutil is a generated GoMock package.
+package testutil
+
+import (
+	context "context"
+	reflect "reflect"
+	types0 "speculod/x/prediction/types"
+
+	address "cosmossdk.io/core/address"
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthKeeper is a mock of AuthKeeper interface.
+type MockAuthKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthKeeperMockRecorder is the mock recorder for MockAuthKeeper.
+type MockAuthKeeperMockRecorder struct {
+	mock *MockAuthKeeper
+}
+
+// NewMockAuthKeeper creates a new mock instance.
+func NewMockAuthKeeper(ctrl *gomock.Controller) *MockAuthKeeper {
+	mock := &MockAuthKeeper{ctrl: ctrl}
+	mock.recorder = &MockAuthKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthKeeper) EXPECT() *MockAuthKeeperMockRecorder {
+	return m.recorder
+}
+
+// AddressCodec mocks base method.
+func (m *MockAuthKeeper) AddressCodec() address.Codec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressCodec")
+	ret0, _ := ret[0].(address.Codec)
+	return ret0
+}
+
+// AddressCodec indicates an expected call of AddressCodec.
+func (mr *MockAuthKeeperMockRecorder) AddressCodec() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressCodec", reflect.TypeOf((*MockAuthKeeper)(nil).AddressCodec))
+}
+
+// GetAccount mocks base method.
+func (m *MockAuthKeeper) GetAccount(arg0 context.Context, arg1 types.AccAddress) types.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(types.AccountI)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockAuthKeeperMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAuthKeeper)(nil).GetAccount), arg0, arg1)
+}
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// SpendableCoins mocks base method.
+func (m *MockBankKeeper) SpendableCoins(arg0 context.Context, arg1 types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoins", arg0, arg1)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// SpendableCoins indicates an expected call of SpendableCoins.
+func (mr *MockBankKeeperMockRecorder) SpendableCoins(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), arg0, arg1)
+}
+
+// MockPredictionKeeper is a mock of PredictionKeeper interface.
+type MockPredictionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockPredictionKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockPredictionKeeperMockRecorder is the mock recorder for MockPredictionKeeper.
+type MockPredictionKeeperMockRecorder struct {
+	mock *MockPredictionKeeper
+}
+
+// NewMockPredictionKeeper creates a new mock instance.
+func NewMockPredictionKeeper(ctrl *gomock.Controller) *MockPredictionKeeper {
+	mock := &MockPredictionKeeper{ctrl: ctrl}
+	mock.recorder = &MockPredictionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPredictionKeeper) EXPECT() *MockPredictionKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetPredictionMarket mocks base method.
+func (m *MockPredictionKeeper) GetPredictionMarket(ctx types.Context, marketId uint64) (types0.PredictionMarket, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPredictionMarket", ctx, marketId)
+	ret0, _ := ret[0].(types0.PredictionMarket)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPredictionMarket indicates an expected call of GetPredictionMarket.
+func (mr *MockPredictionKeeperMockRecorder) GetPredictionMarket(ctx, marketId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPredictionMarket", reflect.TypeOf((*MockPredictionKeeper)(nil).GetPredictionMarket), ctx, marketId)
+}
+
+// IsGroupParticipant mocks base method.
+func (m *MockPredictionKeeper) IsGroupParticipant(ctx context.Context, groupId, arg2 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsGroupParticipant", ctx, groupId, arg2)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsGroupParticipant indicates an expected call of IsGroupParticipant.
+func (mr *MockPredictionKeeperMockRecorder) IsGroupParticipant(ctx, groupId, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsGroupParticipant", reflect.TypeOf((*MockPredictionKeeper)(nil).IsGroupParticipant), ctx, groupId, arg2)
+}
+
+// ResolveMarket mocks base method.
+func (m *MockPredictionKeeper) ResolveMarket(ctx types.Context, marketId uint64, outcome string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveMarket", ctx, marketId, outcome)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveMarket indicates an expected call of ResolveMarket.
+func (mr *MockPredictionKeeperMockRecorder) ResolveMarket(ctx, marketId, outcome any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveMarket", reflect.TypeOf((*MockPredictionKeeper)(nil).ResolveMarket), ctx, marketId, outcome)
+}
+
+// ValidateOutcome mocks base method.
+func (m *MockPredictionKeeper) ValidateOutcome(outcomes []string, vote string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateOutcome", outcomes, vote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateOutcome indicates an expected call of ValidateOutcome.
+func (mr *MockPredictionKeeperMockRecorder) ValidateOutcome(outcomes, vote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateOutcome", reflect.TypeOf((*MockPredictionKeeper)(nil).ValidateOutcome), outcomes, vote)
+}
+
+// MockReputationKeeper is a mock of ReputationKeeper interface.
+type MockReputationKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockReputationKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockReputationKeeperMockRecorder is the mock recorder for MockReputationKeeper.
+type MockReputationKeeperMockRecorder struct {
+	mock *MockReputationKeeper
+}
+
+// NewMockReputationKeeper creates a new mock instance.
+func NewMockReputationKeeper(ctrl *gomock.Controller) *MockReputationKeeper {
+	mock := &MockReputationKeeper{ctrl: ctrl}
+	mock.recorder = &MockReputationKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReputationKeeper) EXPECT() *MockReputationKeeperMockRecorder {
+	return m.recorder
+}
+
+// AdjustReputationScore mocks base method.
+func (m *MockReputationKeeper) AdjustReputationScore(ctx types.Context, arg1, groupId string, adjustment int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustReputationScore", ctx, arg1, groupId, adjustment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdjustReputationScore indicates an expected call of AdjustReputationScore.
+func (mr *MockReputationKeeperMockRecorder) AdjustReputationScore(ctx, arg1, groupId, adjustment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustReputationScore", reflect.TypeOf((*MockReputationKeeper)(nil).AdjustReputationScore), ctx, arg1, groupId, adjustment)
+}
+
+// GetReputationScore mocks base method.
+func (m *MockReputationKeeper) GetReputationScore(ctx types.Context, arg1, groupId string) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReputationScore", ctx, arg1, groupId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetReputationScore indicates an expected call of GetReputationScore.
+func (mr *MockReputationKeeperMockRecorder) GetReputationScore(ctx, arg1, groupId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReputationScore", reflect.TypeOf((*MockReputationKeeper)(nil).GetReputationScore), ctx, arg1, groupId)
+}
+
+// MockParamSubspace is a mock of ParamSubspace interface.
+type MockParamSubspace struct {
+	ctrl     *gomock.Controller
+	recorder *MockParamSubspaceMockRecorder
+	isgomock struct{}
+}
+
+// MockParamSubspaceMockRecorder is the mock recorder for MockParamSubspace.
+type MockParamSubspaceMockRecorder struct {
+	mock *MockParamSubspace
+}
+
+// NewMockParamSubspace creates a new mock instance.
+func NewMockParamSubspace(ctrl *gomock.Controller) *MockParamSubspace {
+	mock := &MockParamSubspace{ctrl: ctrl}
+	mock.recorder = &MockParamSubspaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParamSubspace) EXPECT() *MockParamSubspaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockParamSubspace) Get(arg0 context.Context, arg1 []byte, arg2 any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockParamSubspaceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockParamSubspace)(nil).Get), arg0, arg1, arg2)
+}
+
+// Set mocks base method.
+func (m *MockParamSubspace) Set(arg0 context.Context, arg1 []byte, arg2 any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", arg0, arg1, arg2)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockParamSubspaceMockRecorder) Set(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockParamSubspace)(nil).Set), arg0, arg1, arg2)
+}
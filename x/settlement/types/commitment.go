@@ -0,0 +1,13 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// VoteCommitment returns the commitment of a vote revealed with nonce: the hex
+// encoded sha256 of the vote followed by the nonce.
+func VoteCommitment(vote, nonce string) string {
+	hash := sha256.Sum256([]byte(vote + nonce))
+	return hex.EncodeToString(hash[:])
+}
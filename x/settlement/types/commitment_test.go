@@ -0,0 +1,15 @@
+package types_test
+
+import (
+	"testing"
+
+	"speculod/x/settlement/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteCommitment(t *testing.T) {
+	// echo -n "Yessecret123" | sha256sum
+	require.Equal(t, "8c00925622ed6c982e87471c1f49771474bd067173986d8032fec7c00ff02e38", types.VoteCommitment("Yes", "secret123"))
+	require.NotEqual(t, types.VoteCommitment("Yes", "secret123"), types.VoteCommitment("No", "secret123"))
+}
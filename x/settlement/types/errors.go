@@ -19,4 +19,6 @@ var (
 	ErrNoRevealsFound             = errorsmod.Register(ModuleName, 10, "no reveals found for this market")
 	ErrInvalidNonce               = errorsmod.Register(ModuleName, 11, "invalid nonce")
 	ErrReputationAdjustmentFailed = errorsmod.Register(ModuleName, 12, "reputation adjustment failed")
+	ErrNotGroupParticipant        = errorsmod.Register(ModuleName, 13, "voter is not a participant of the market group")
+	ErrInvariantBroken            = errorsmod.Register(ModuleName, 14, "invariant broken")
 )
@@ -25,6 +25,8 @@ type BankKeeper interface {
 type PredictionKeeper interface {
 	GetPredictionMarket(ctx sdk.Context, marketId uint64) (types.PredictionMarket, bool)
 	ValidateOutcome(outcomes []string, vote string) error
+	ResolveMarket(ctx sdk.Context, marketId uint64, outcome string) error
+	IsGroupParticipant(ctx context.Context, groupId string, address string) bool
 }
 
 // ReputationKeeper defines the expected interface for the Reputation module.
@@ -17,3 +17,11 @@ const (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_settlement")
+
+var (
+	// CommitsKey is the prefix of the vote commits collection
+	CommitsKey = collections.NewPrefix("commits")
+
+	// RevealsKey is the prefix of the vote reveals collection
+	RevealsKey = collections.NewPrefix("reveals")
+)
@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"speculod/x/speculod/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. Params were
+// only ever written by InitGenesis, so exporting genesis fails on stores that
+// lack them; v2 sets missing params to their defaults.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	has, err := params.Has(ctx)
+	if err != nil || has {
+		return err
+	}
+	return params.Set(ctx, types.DefaultParams())
+}
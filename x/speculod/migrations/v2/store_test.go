@@ -0,0 +1,38 @@
+package v2_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"speculod/x/speculod/keeper"
+	v2 "speculod/x/speculod/migrations/v2"
+	module "speculod/x/speculod/module"
+	"speculod/x/speculod/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	k := keeper.NewKeeper(storeService, cdc, addressCodec, authtypes.NewModuleAddress(types.GovModuleName))
+
+	// Missing params are set to their defaults
+	_, err := k.ExportGenesis(ctx)
+	require.Error(t, err)
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+	genesis, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), genesis.Params)
+
+}